@@ -61,6 +61,8 @@ var (
 	ErrNotGappedAlphabet   = errors.New("align: alphabet does not have gap at position 0")
 	ErrTypeNotHandled      = errors.New("align: sequence type not handled")
 	ErrMatrixNotSquare     = errors.New("align: scoring matrix is not square")
+	ErrNegativeBandWidth   = errors.New("align: negative band width")
+	ErrBandExcludesEnds    = errors.New("align: band does not include both sequence ends")
 )
 
 type ErrMatrixWrongSize struct {
@@ -89,6 +91,13 @@ func max2(a, b int) int {
 	return b
 }
 
+func min2(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 func add(a, b int) int {
 	if a == minInt || b == minInt {
 		return minInt
@@ -96,6 +105,36 @@ func add(a, b int) int {
 	return a + b
 }
 
+// band describes the storage layout of a diagonal band of a dynamic programming
+// table. Rows are stored contiguously with a guard cell at each end so that the
+// up and left neighbours of any cell in the band can be addressed without bounds
+// checks. The diagonal of cell (i, j) is j-i.
+type band struct {
+	lo, hi int // lowest and highest diagonals in the band
+	stride int // number of cells stored for each row
+}
+
+func newBand(width, centre int) band {
+	return band{lo: centre - width, hi: centre + width, stride: 2*width + 3}
+}
+
+// index returns the position of cell (i, j) in the band table. The diagonal
+// neighbour of the returned position p is at p-stride, the up neighbour is at
+// p-stride+1 and the left neighbour is at p-1.
+func (b band) index(i, j int) int { return i*b.stride + j - i - b.lo + 1 }
+
+// contains returns whether cell (i, j) lies within the band.
+func (b band) contains(i, j int) bool {
+	d := j - i
+	return b.lo <= d && d <= b.hi
+}
+
+// span returns the half open range of columns of row i that are within the band
+// and within a table with c columns.
+func (b band) span(i, c int) (from, to int) {
+	return max2(i+b.lo, 0), min2(i+b.hi+1, c)
+}
+
 type feature struct {
 	start, end int
 	loc        feat.Feature
@@ -5,13 +5,20 @@
 package align
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/rand"
 	"strings"
 	"testing"
 
+	"github.com/biogo/biogo/align/matrix"
 	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
 	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
 	"gopkg.in/check.v1"
 )
 
@@ -50,6 +57,829 @@ func (s *S) TestIssue58(c *check.C) {
 	c.Check(fmt.Sprint(aln), check.Equals, "[[0,4)/-=-5 [4,7)/[0,3)=3 [7,32)/-=-26 [32,34)/[3,5)=2 [34,43)/-=-10 [43,46)/[5,8)=3 [46,60)/-=-15]")
 }
 
+func (s *S) TestBandedFullWidth(c *check.C) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+
+	m := dnaMatrix
+	for _, test := range []struct {
+		full, banded Aligner
+	}{
+		{full: SW(m), banded: SWBanded{Matrix: m, Width: sa.Len() + sb.Len()}},
+		{full: SW(m), banded: SWBanded{Matrix: m, Width: 50}},
+		{full: NW(m), banded: NWBanded{Matrix: m, Width: sa.Len() + sb.Len()}},
+		{full: NW(m), banded: NWBanded{Matrix: m, Width: 100, Centre: sb.Len() - sa.Len()}},
+	} {
+		// Both the Letters and QLetters implementations are checked.
+		for _, in := range [][2]AlphabetSlicer{
+			{sa, sb},
+			{withQuality(sa.(*linear.Seq), 40), withQuality(sb.(*linear.Seq), 40)},
+		} {
+			want, err := test.full.Align(in[0], in[1])
+			c.Assert(err, check.Equals, nil)
+			got, err := test.banded.Align(in[0], in[1])
+			c.Assert(err, check.Equals, nil)
+			c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("%T %T", test.banded, in[0]))
+		}
+	}
+}
+
+func (s *S) TestBandErrors(c *check.C) {
+	sa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	sa.Alpha = alphabet.DNAgapped
+	sb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	sb.Alpha = alphabet.DNAgapped
+
+	m := dnaMatrix
+	_, err := SWBanded{Matrix: m, Width: -1}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrNegativeBandWidth)
+	_, err = NWBanded{Matrix: m, Width: -1}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrNegativeBandWidth)
+	_, err = NWBanded{Matrix: m, Width: 1, Centre: -1}.Align(sa, sb)
+	c.Check(err, check.Equals, nil)
+	_, err = NWBanded{Matrix: m, Width: 1}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrBandExcludesEnds)
+	_, err = NWBanded{Matrix: m, Width: 2, Centre: 1}.Align(sa, sb)
+	c.Check(err, check.Equals, ErrBandExcludesEnds)
+}
+
+// dnaMatrix is the linear gap penalty DNA scoring matrix used by the tests.
+var dnaMatrix = Linear{
+	{0, -5, -5, -5, -5},
+	{-5, 10, -3, -1, -4},
+	{-5, -3, 9, -5, 0},
+	{-5, -1, -5, 7, -3},
+	{-5, -4, 0, -3, 8},
+}
+
+// randDNA returns a random gapped DNA sequence of between 1 and max bases.
+func randDNA(rnd *rand.Rand, max int) *linear.Seq {
+	b := make([]byte, 1+rnd.Intn(max))
+	for i := range b {
+		b[i] = "acgt"[rnd.Intn(4)]
+	}
+	s := &linear.Seq{Seq: alphabet.BytesToLetters(b)}
+	s.Alpha = alphabet.DNAgapped
+	return s
+}
+
+// withQuality returns a copy of s with all letters given the quality q.
+func withQuality(s *linear.Seq, q alphabet.Qphred) *linear.QSeq {
+	ql := make([]alphabet.QLetter, s.Len())
+	for i, l := range s.Seq {
+		ql[i] = alphabet.QLetter{L: l, Q: q}
+	}
+	return linear.NewQSeq(s.ID, ql, s.Alpha, alphabet.Sanger)
+}
+
+func totalScore(aln []feat.Pair) int {
+	var s int
+	for _, fp := range aln {
+		s += fp.(interface{ Score() int }).Score()
+	}
+	return s
+}
+
+func isComplete(aln []feat.Pair, rLen, qLen int) bool {
+	var i, j int
+	for _, fp := range aln {
+		f := fp.Features()
+		if f[0].Start() != i || f[1].Start() != j {
+			return false
+		}
+		i, j = f[0].End(), f[1].End()
+	}
+	return i == rLen && j == qLen
+}
+
+func (s *S) TestHirschberg(c *check.C) {
+	defer func(cells int) { hirschbergCells = cells }(hirschbergCells)
+
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+		for _, test := range []struct {
+			full, linear Aligner
+		}{
+			{full: NW(m), linear: Hirschberg(m)},
+			{full: NWAffine{Matrix: m, GapOpen: -7}, linear: HirschbergAffine{Matrix: m, GapOpen: -7}},
+		} {
+			hirschbergCells = 1 << 16
+			want, err := test.full.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			got, err := test.linear.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+
+			hirschbergCells = rnd.Intn(20)
+			got, err = test.linear.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("%T %v %v", test.linear, sa, sb))
+			c.Check(isComplete(got, sa.Len(), sb.Len()), check.Equals, true)
+		}
+	}
+
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+	hirschbergCells = 1 << 16
+	for _, test := range []struct {
+		full, linear Aligner
+	}{
+		{full: NW(m), linear: Hirschberg(m)},
+		{full: NWAffine{Matrix: m, GapOpen: -7}, linear: HirschbergAffine{Matrix: m, GapOpen: -7}},
+	} {
+		want, err := test.full.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		got, err := test.linear.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("%T", test.linear))
+		c.Check(isComplete(got, sa.Len(), sb.Len()), check.Equals, true)
+	}
+}
+
+func (s *S) TestSuboptimal(c *check.C) {
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	type local interface {
+		Aligner
+		Score(reference, query AlphabetSlicer) (int, error)
+		Suboptimal(reference, query AlphabetSlicer, k int) ([]Hit, error)
+	}
+	for i := 0; i < 500; i++ {
+		sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+		for _, a := range []local{SW(m), SWAffine{Matrix: m, GapOpen: -3}} {
+			want, err := a.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			score, err := a.Score(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(score, check.Equals, totalScore(want), check.Commentf("%T %v %v", a, sa, sb))
+
+			hits, err := a.Suboptimal(sa, sb, 5)
+			c.Assert(err, check.Equals, nil)
+			c.Check(len(hits) <= 5, check.Equals, true)
+			if score > 0 {
+				c.Assert(len(hits) > 0, check.Equals, true)
+				c.Check(fmt.Sprint(hits[0].Pairs), check.Equals, fmt.Sprint(want))
+			}
+			aligned := make(map[[2]int]bool)
+			for _, h := range hits {
+				c.Check(totalScore(h.Pairs), check.Equals, h.Score)
+				for _, fp := range h.Pairs {
+					f := fp.Features()
+					if f[0].Len() != f[1].Len() {
+						continue
+					}
+					for o := 0; o < f[0].Len(); o++ {
+						pos := [2]int{f[0].Start() + o, f[1].Start() + o}
+						c.Check(aligned[pos], check.Equals, false, check.Commentf("%T %v %v", a, sa, sb))
+						aligned[pos] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func (s *S) TestLaneArithmetic(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 10000; n++ {
+		var a, b uint64
+		for k := 0; k < lanes; k++ {
+			a |= uint64(rnd.Intn(laneMax+1)) << (k * laneBits)
+			b |= uint64(rnd.Intn(laneMax+1)) << (k * laneBits)
+		}
+		if n%3 == 0 {
+			b = a
+		}
+		for k := 0; k < lanes; k++ {
+			x, y := lane(a, k), lane(b, k)
+			c.Check(lane(subSat(a, b), k), check.Equals, max2(x-y, 0))
+			c.Check(lane(maxLanes(a, b), k), check.Equals, max2(x, y))
+			c.Check(lane(nonZero(subSat(a, b)), k) != 0, check.Equals, x > y)
+		}
+	}
+}
+
+func (s *S) TestStriped(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randMatrix := func() Linear {
+		m := make(Linear, 5)
+		for i := range m {
+			m[i] = make([]int, 5)
+		}
+		for i := 1; i < 5; i++ {
+			m[i][0] = -rnd.Intn(6)
+			m[0][i] = -rnd.Intn(6)
+			for j := 1; j < 5; j++ {
+				if i == j {
+					m[i][j] = 1 + rnd.Intn(10)
+				} else {
+					m[i][j] = 2 - rnd.Intn(8)
+				}
+			}
+		}
+		return m
+	}
+	for i := 0; i < 2000; i++ {
+		sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+		a := SWAffine{Matrix: randMatrix(), GapOpen: -rnd.Intn(8)}
+		want, err := a.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		got, err := SWStriped(a).Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("%v %v %v", a, sa, sb))
+
+		score, err := SWStriped(a).Score(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(score, check.Equals, totalScore(want), check.Commentf("%v %v %v", a, sa, sb))
+	}
+
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	sa, _ := r.Read()
+	sb, _ := r.Read()
+	for _, a := range []SWAffine{
+		{
+			Matrix: Linear{
+				{0, -1, -1, -1, -1},
+				{-1, 2, -1, -1, -1},
+				{-1, -1, 2, -1, -1},
+				{-1, -1, -1, 2, -1},
+				{-1, -1, -1, -1, 2},
+			},
+			GapOpen: -5,
+		},
+		{
+			// Positive gap scores are aligned by SWAffine.
+			Matrix: Linear{
+				{0, 1, 1, 1, 1},
+				{1, 2, -1, -1, -1},
+				{1, -1, 2, -1, -1},
+				{1, -1, -1, 2, -1},
+				{1, -1, -1, -1, 2},
+			},
+			GapOpen: -5,
+		},
+	} {
+		want, err := a.Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		got, err := SWStriped(a).Align(sa, sb)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+	}
+
+	// Empty sequences are aligned in the same way as by SWAffine.
+	empty := &linear.Seq{}
+	empty.Alpha = alphabet.DNAgapped
+	for _, pair := range [][2]AlphabetSlicer{{empty, sb}, {sa, empty}, {empty, empty}} {
+		a := SWAffine{Matrix: dnaMatrix, GapOpen: -3}
+		want, err := a.Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		got, err := SWStriped(a).Align(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+		score, err := SWStriped(a).Score(pair[0], pair[1])
+		c.Assert(err, check.Equals, nil)
+		c.Check(score, check.Equals, totalScore(want))
+	}
+
+	// Scores too large to be held in a lane are aligned by SWAffine.
+	b := []byte(strings.Repeat("acgt", 1000))
+	long := &linear.Seq{Seq: alphabet.BytesToLetters(b)}
+	long.Alpha = alphabet.DNAgapped
+	a := SWAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 100, -1, -1, -1},
+			{-1, -1, 100, -1, -1},
+			{-1, -1, -1, 100, -1},
+			{-1, -1, -1, -1, 100},
+		},
+		GapOpen: -5,
+	}
+	score, err := SWStriped(a).Score(long, long)
+	c.Assert(err, check.Equals, nil)
+	c.Check(score, check.Equals, 400000)
+	aln, err := SWStriped(a).Align(long, long)
+	c.Assert(err, check.Equals, nil)
+	c.Check(fmt.Sprint(aln), check.Equals, "[[0,4000)/[0,4000)=400000]")
+}
+
+func (s *S) TestQuality(c *check.C) {
+	m := Linear{
+		{0, -50, -50, -50, -50},
+		{-50, 100, -30, -10, -40},
+		{-50, -30, 90, -50, 0},
+		{-50, -10, -50, 70, -30},
+		{-50, -40, 0, -30, 80},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeqs := func() (*linear.Seq, *linear.QSeq) {
+		s := randDNA(rnd, 50)
+		return s, withQuality(s, 60)
+	}
+	for i := 0; i < 200; i++ {
+		sa, qsa := randSeqs()
+		sb, qsb := randSeqs()
+		for _, test := range []struct {
+			plain, weighted Aligner
+		}{
+			{plain: SW(m), weighted: SWQuality(m)},
+			{plain: NW(m), weighted: NWQuality(m)},
+			{plain: SWAffine{Matrix: m, GapOpen: -70}, weighted: SWAffineQuality{Matrix: m, GapOpen: -70}},
+			{plain: NWAffine{Matrix: m, GapOpen: -70}, weighted: NWAffineQuality{Matrix: m, GapOpen: -70}},
+		} {
+			want, err := test.plain.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+
+			// Sequences without qualities are aligned without weighting.
+			got, err := test.weighted.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+
+			// High quality sequences are aligned as if unweighted.
+			got, err = test.weighted.Align(qsa, qsb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("%T %v %v", test.weighted, sa, sb))
+			if _, ok := test.weighted.(SWQuality); ok {
+				c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want))
+			}
+			if _, ok := test.weighted.(NWQuality); ok {
+				c.Check(isComplete(got, sa.Len(), sb.Len()), check.Equals, true)
+			}
+		}
+	}
+
+	ref := linear.NewQSeq("", nil, alphabet.DNAgapped, alphabet.Sanger)
+	for _, l := range []byte("acgtacgtacgt") {
+		ref.Seq = append(ref.Seq, alphabet.QLetter{L: alphabet.Letter(l), Q: 40})
+	}
+	var scores []int
+	for _, q := range []alphabet.Qphred{40, 20, 3} {
+		query := linear.NewQSeq("", nil, alphabet.DNAgapped, alphabet.Sanger)
+		for i, l := range []byte("acgtaggtacgt") {
+			query.Seq = append(query.Seq, alphabet.QLetter{L: alphabet.Letter(l), Q: 40})
+			if i == 5 {
+				query.Seq[i].Q = q
+			}
+		}
+		aln, err := NWQuality(m).Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		scores = append(scores, totalScore(aln))
+	}
+	c.Check(scores[0] < scores[1] && scores[1] < scores[2], check.Equals, true, check.Commentf("%v", scores))
+
+	c.Check(QualityScore(100, 40, 40), check.Equals, 100)
+	c.Check(QualityScore(-100, 10, 40), check.Equals, -90)
+	c.Check(QualityScore(-100, 0, 40), check.Equals, 0)
+
+	_, err := SWQuality(m).Align(ref, &linear.Seq{Seq: alphabet.BytesToLetters([]byte("acgt")), Annotation: seq.Annotation{Alpha: alphabet.DNAgapped}})
+	c.Check(err, check.Equals, ErrMismatchedTypes)
+}
+
+func (s *S) TestAlignment(c *check.C) {
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+		for _, aligner := range []Aligner{
+			SW(m), NW(m),
+			SWAffine{Matrix: m, GapOpen: -7}, NWAffine{Matrix: m, GapOpen: -7},
+			Fitted(m), FittedAffine{Matrix: m, GapOpen: -7},
+		} {
+			aln, err := aligner.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			a, err := NewAlignment(sa, sb, aln)
+			c.Assert(err, check.Equals, nil, check.Commentf("%T %v", aligner, aln))
+
+			c.Check(a.EditDistance(), check.Equals, a.Count(Mismatch)+a.Count(Insertion)+a.Count(Deletion))
+			c.Check(a.Len(), check.Equals, a.Count(Match)+a.EditDistance())
+			rs, re := a.Reference()
+			qs, qe := a.Query()
+			c.Check(a.Len(), check.Equals, re-rs+a.Count(Insertion))
+			c.Check(a.Len(), check.Equals, qe-qs+a.Count(Deletion))
+
+			for _, cigar := range []Cigar{a.Cigar(), a.ExtendedCigar()} {
+				parsed, err := ParseCigar(cigar.String())
+				c.Assert(err, check.Equals, nil)
+				c.Check(parsed, check.DeepEquals, cigar)
+				b, err := FromCigar(sa, sb, rs, parsed)
+				c.Assert(err, check.Equals, nil)
+				c.Check(b.ExtendedCigar(), check.DeepEquals, a.ExtendedCigar())
+				c.Check(b.Columns(), check.DeepEquals, a.Columns())
+			}
+		}
+	}
+
+	for _, bad := range []struct {
+		cigar string
+		err   string
+	}{
+		{cigar: "10", err: `align: cigar "10" ends with a length`},
+		{cigar: "3M0", err: `align: cigar "3M0" ends with a length`},
+		{cigar: "M", err: "align: missing cigar length at position 0"},
+		{cigar: "3M2Q", err: `align: invalid cigar operation 'Q' at position 3`},
+		{cigar: "0M", err: "align: zero cigar length at position 1"},
+		{cigar: "3M00I", err: "align: zero cigar length at position 4"},
+	} {
+		_, err := ParseCigar(bad.cigar)
+		c.Check(err, check.ErrorMatches, bad.err, check.Commentf("%q", bad.cigar))
+	}
+	sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+	for _, bad := range []string{"1M1S1M", "1000M"} {
+		cigar, err := ParseCigar(bad)
+		c.Assert(err, check.Equals, nil)
+		_, err = FromCigar(sa, sb, 0, cigar)
+		c.Check(err, check.Not(check.Equals), nil, check.Commentf("%q", bad))
+	}
+}
+
+func (s *S) TestOverlap(c *check.C) {
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	sub := func(s *linear.Seq, start, end int) *linear.Seq {
+		t := &linear.Seq{Seq: s.Seq[start:end]}
+		t.Alpha = alphabet.DNAgapped
+		return t
+	}
+	for i := 0; i < 200; i++ {
+		sa, sb := randDNA(rnd, 12), randDNA(rnd, 12)
+		for _, test := range []struct {
+			overlap, global Aligner
+		}{
+			{overlap: Overlap(m), global: NW(m)},
+			{overlap: OverlapAffine{Matrix: m, GapOpen: -7}, global: NWAffine{Matrix: m, GapOpen: -7}},
+		} {
+			aln, err := test.overlap.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			_, err = NewAlignment(sa, sb, aln)
+			c.Check(err, check.Equals, nil)
+
+			// The overlap must begin and end at the end of a sequence.
+			f := aln[0].Features()
+			c.Check(f[0].Start() == 0 || f[1].Start() == 0, check.Equals, true)
+			f = aln[len(aln)-1].Features()
+			c.Check(f[0].End() == sa.Len() || f[1].End() == sb.Len(), check.Equals, true)
+
+			// Find the best global alignment of sequence
+			// ends by brute force.
+			best := minInt
+			for i0 := range sa.Seq {
+				for j0 := range sb.Seq {
+					if i0 != 0 && j0 != 0 {
+						continue
+					}
+					for i1 := i0 + 1; i1 <= sa.Len(); i1++ {
+						for j1 := j0 + 1; j1 <= sb.Len(); j1++ {
+							if i1 != sa.Len() && j1 != sb.Len() {
+								continue
+							}
+							want, err := test.global.Align(sub(sa, i0, i1), sub(sb, j0, j1))
+							c.Assert(err, check.Equals, nil)
+							best = max2(best, totalScore(want))
+						}
+					}
+				}
+			}
+			c.Check(totalScore(aln), check.Equals, best, check.Commentf("%T %v %v %v", test.overlap, sa, sb, aln))
+		}
+	}
+}
+
+func (s *S) TestDualAffine(c *check.C) {
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		sa, sb := randDNA(rnd, 50), randDNA(rnd, 50)
+
+		// With an unusable long gap piece, dual affine
+		// alignments score as affine alignments.
+		off := DualAffine{Matrix: m, GapOpen: -7, LongGapOpen: -1e6, LongGapExtend: -1}
+		for _, test := range []struct {
+			affine, dual Aligner
+		}{
+			{affine: NWAffine{Matrix: m, GapOpen: -7}, dual: NWDualAffine(off)},
+			{affine: SWAffine{Matrix: m, GapOpen: -7}, dual: SWDualAffine(off)},
+		} {
+			want, err := test.affine.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			got, err := test.dual.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("%T %v %v", test.dual, sa, sb))
+		}
+
+		// With a usable long gap piece, dual affine
+		// alignments score at least as well.
+		on := DualAffine{Matrix: m, GapOpen: -7, LongGapOpen: -20, LongGapExtend: -1}
+		for _, test := range []struct {
+			affine, dual Aligner
+		}{
+			{affine: NWAffine{Matrix: m, GapOpen: -7}, dual: NWDualAffine(on)},
+			{affine: SWAffine{Matrix: m, GapOpen: -7}, dual: SWDualAffine(on)},
+		} {
+			want, err := test.affine.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			got, err := test.dual.Align(sa, sb)
+			c.Assert(err, check.Equals, nil)
+			c.Check(totalScore(got) >= totalScore(want), check.Equals, true, check.Commentf("%T %v %v", test.dual, sa, sb))
+			_, err = NewAlignment(sa, sb, got)
+			c.Check(err, check.Equals, nil)
+			if _, ok := test.dual.(NWDualAffine); ok {
+				c.Check(isComplete(got, sa.Len(), sb.Len()), check.Equals, true)
+			}
+		}
+	}
+}
+
+func (s *S) TestTranslated(c *check.C) {
+	const (
+		open   = -10
+		extend = -1
+		shift  = -15
+	)
+	m := make(Linear, len(matrix.BLOSUM62))
+	for i, row := range matrix.BLOSUM62 {
+		m[i] = append([]int(nil), row...)
+		m[i][0] = extend
+		m[0][i] = extend
+	}
+	m[0][0] = 0
+	translate := func(b []byte) []byte {
+		p := make([]byte, 0, len(b)/3)
+		for i := 0; i+3 <= len(b); i += 3 {
+			l := alphabet.BytesToLetters(b[i : i+3])
+			p = append(p, byte(alphabet.Standard.TranslateBases(l[0], l[1], l[2])))
+		}
+		return p
+	}
+	protein := func(b []byte) *linear.Seq {
+		s := &linear.Seq{Seq: alphabet.BytesToLetters(b)}
+		s.Alpha = alphabet.Protein
+		return s
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randBases := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "acgt"[rnd.Intn(4)]
+		}
+		return b
+	}
+
+	for i := 0; i < 200; i++ {
+		ref := randBases(1 + rnd.Intn(90))
+		sr := &linear.Seq{Seq: alphabet.BytesToLetters(ref)}
+		sr.Alpha = alphabet.DNA
+		var sq *linear.Seq
+		if rnd.Intn(2) == 0 {
+			sq = protein(translate(randBases(3 + rnd.Intn(60))))
+		} else {
+			// Use a mutated translation of part of the reference.
+			start := rnd.Intn(len(ref))
+			p := translate(ref[start:])
+			for k := range p {
+				if rnd.Intn(5) == 0 {
+					p[k] = "ACDEFGHIKLMNPQRSTVWY"[rnd.Intn(20)]
+				}
+			}
+			if len(p) == 0 {
+				p = []byte{'M'}
+			}
+			sq = protein(p)
+		}
+
+		// Without frameshifts, translated alignments score
+		// as the best affine alignment of the three frames.
+		got, err := Translated{Matrix: m, GapOpen: open, GapExtend: extend, Frameshift: -1e6}.Align(sr, sq)
+		c.Assert(err, check.Equals, nil)
+		var best int
+		for f := 0; f < 3 && f < len(ref); f++ {
+			want, err := SWAffine{Matrix: m, GapOpen: open}.Align(protein(translate(ref[f:])), sq)
+			if err != nil {
+				continue
+			}
+			best = max2(best, totalScore(want))
+		}
+		c.Check(totalScore(got), check.Equals, best, check.Commentf("%s %s %v", ref, sq, got))
+
+		// With frameshifts allowed, translated alignments score at least
+		// as well and describe contiguous codon aligned feature pairs.
+		got, err = Translated{Matrix: m, GapOpen: open, GapExtend: extend, Frameshift: shift}.Align(sr, sq)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got) >= best, check.Equals, true, check.Commentf("%s %s %v", ref, sq, got))
+		var ri, qi int
+		for k, fp := range got {
+			f := fp.Features()
+			rf, qf := f[0], f[1]
+			if k != 0 {
+				c.Check(rf.Start(), check.Equals, ri)
+				c.Check(qf.Start(), check.Equals, qi)
+			}
+			ri, qi = rf.End(), qf.End()
+			c.Check(fp.(Framer).Frame(), check.Equals, rf.Start()%3)
+			switch {
+			case qf.Len() == 0 && rf.Len()%3 != 0:
+				c.Check(rf.Len() < 3, check.Equals, true)
+			case rf.Len() != 0 && qf.Len() != 0:
+				c.Check(rf.Len(), check.Equals, 3*qf.Len())
+				score := 0
+				for n, aa := range translate(ref[rf.Start():rf.End()]) {
+					score += m[alphabet.Protein.IndexOf(alphabet.Letter(aa))][alphabet.Protein.IndexOf(sq.Seq[qf.Start()+n])]
+				}
+				c.Check(fp.(*framePair).Score(), check.Equals, score)
+			}
+		}
+	}
+
+	// A single inserted base is described by a frameshift.
+	ref := []byte("ggcatggcgaaacgctattgcgatgaatggcagcacaaatttccgattagc")
+	p := translate(ref[3:])
+	ins := append(append(append([]byte(nil), ref[:24]...), 'a'), ref[24:]...)
+	sr := &linear.Seq{Seq: alphabet.BytesToLetters(ins)}
+	sr.Alpha = alphabet.DNA
+	got, err := Translated{Matrix: m, GapOpen: open, GapExtend: extend, Frameshift: shift}.Align(sr, protein(p))
+	c.Assert(err, check.Equals, nil)
+	c.Check(fmt.Sprint(got), check.Equals, "[[3,24)/[0,7)=41 [24,25)/-=-15 [25,52)/[7,16)=55]")
+	c.Check(got[0].(Framer).Frame(), check.Equals, 0)
+	c.Check(got[2].(Framer).Frame(), check.Equals, 1)
+
+	// Sequence types are checked.
+	_, err = Translated{Matrix: m}.Align(protein(p), protein(p))
+	c.Check(err, check.Equals, ErrNotNucleic)
+	_, err = Translated{Matrix: m}.Align(sr, sr)
+	c.Check(err, check.Equals, ErrNotProtein)
+}
+
+func (s *S) TestBatch(c *check.C) {
+	m := dnaMatrix
+	rnd := rand.New(rand.NewSource(1))
+	jobs := make([]Job, 500)
+	for i := range jobs {
+		jobs[i] = Job{Reference: randDNA(rnd, 100), Query: randDNA(rnd, 100)}
+	}
+	protein := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("mak"))}
+	protein.Alpha = alphabet.Protein
+	jobs[100].Query = protein
+
+	for _, a := range []Aligner{
+		SW(m),
+		NW(m),
+		SWAffine{Matrix: m, GapOpen: -7},
+		NWAffine{Matrix: m, GapOpen: -7},
+		Fitted(m),
+		FittedAffine{Matrix: m, GapOpen: -7},
+		Overlap(m),
+		OverlapAffine{Matrix: m, GapOpen: -7},
+		SWDualAffine{Matrix: m, GapOpen: -7, LongGapOpen: -20, LongGapExtend: -1},
+	} {
+		for _, workers := range []int{0, 1, 4} {
+			results := Batch{Aligner: a, Workers: workers}.AlignAll(jobs)
+			c.Assert(len(results), check.Equals, len(jobs))
+			for i, j := range jobs {
+				want, err := a.Align(j.Reference, j.Query)
+				c.Check(results[i].Err, check.Equals, err, check.Commentf("%T job %d", a, i))
+				c.Check(fmt.Sprint(results[i].Pairs), check.Equals, fmt.Sprint(want), check.Commentf("%T job %d", a, i))
+			}
+		}
+	}
+
+	// Aligner errors are returned in the results.
+	results := Batch{Aligner: failer{}}.AlignAll(jobs[:2])
+	for _, r := range results {
+		c.Check(r.Err, check.ErrorMatches, "oops")
+	}
+}
+
+type failer struct{}
+
+func (failer) Align(_, _ AlphabetSlicer) ([]feat.Pair, error) { return nil, errors.New("oops") }
+
+func (s *S) TestKarlinAltschul(c *check.C) {
+	comp, err := NewComposition(alphabet.Protein, RobinsonRobinson)
+	c.Assert(err, check.Equals, nil)
+
+	// Published BLAST ungapped parameters.
+	for _, test := range []struct {
+		name string
+		m    Linear
+		want KarlinAltschul
+	}{
+		{name: "BLOSUM45", m: matrix.BLOSUM45, want: KarlinAltschul{Lambda: 0.2291, K: 0.0924, H: 0.2514}},
+		{name: "BLOSUM62", m: matrix.BLOSUM62, want: KarlinAltschul{Lambda: 0.3176, K: 0.134, H: 0.4012}},
+		{name: "BLOSUM90", m: matrix.BLOSUM90, want: KarlinAltschul{Lambda: 0.3346, K: 0.190, H: 0.7547}},
+		{name: "PAM30", m: matrix.PAM30, want: KarlinAltschul{Lambda: 0.3400, K: 0.283, H: 1.754}},
+	} {
+		got, err := UngappedParams(test.m, comp, comp)
+		c.Assert(err, check.Equals, nil)
+		c.Check(math.Abs(got.Lambda-test.want.Lambda) < 1e-4, check.Equals, true, check.Commentf("%s lambda %v", test.name, got.Lambda))
+		c.Check(math.Abs(got.K-test.want.K) < 1e-3, check.Equals, true, check.Commentf("%s K %v", test.name, got.K))
+		c.Check(math.Abs(got.H-test.want.H) < 1e-3, check.Equals, true, check.Commentf("%s H %v", test.name, got.H))
+	}
+
+	dna, err := NewComposition(alphabet.DNAgapped, map[alphabet.Letter]float64{'a': 1, 'c': 1, 'g': 1, 't': 1})
+	c.Assert(err, check.Equals, nil)
+	_, err = UngappedParams(matrix.Match(alphabet.DNAgapped, 0, 1, 1), dna, dna)
+	c.Check(err, check.Equals, ErrPositiveExpectedScore)
+	_, err = UngappedParams(matrix.Match(alphabet.DNAgapped, 0, -1, -1), dna, dna)
+	c.Check(err, check.Equals, ErrNoPositiveScore)
+
+	// For +1/-3 scoring of uniform DNA, λ = ln(x) where x is
+	// the real root of x^4 - 4x^3 + 3 = 0 greater than one.
+	m := Linear(matrix.Match(alphabet.DNAgapped, -100, 1, -3))
+	ungapped, err := UngappedParams(m, dna, dna)
+	c.Assert(err, check.Equals, nil)
+	c.Check(math.Abs(ungapped.Lambda-1.374) < 1e-3, check.Equals, true, check.Commentf("lambda %v", ungapped.Lambda))
+
+	// Estimates from simulation approach ungapped values when gaps are prohibitive.
+	est, err := EstimateParams(SW(m), alphabet.DNAgapped, dna, dna, 200, 500, rand.New(rand.NewSource(1)))
+	c.Assert(err, check.Equals, nil)
+	c.Check(math.Abs(est.Lambda-ungapped.Lambda)/ungapped.Lambda < 0.1, check.Equals, true, check.Commentf("estimated lambda %v", est.Lambda))
+
+	p, err := GappedParams(matrix.BLOSUM62, -11, -1)
+	c.Assert(err, check.Equals, nil)
+	c.Check(p, check.Equals, KarlinAltschul{Lambda: 0.267, K: 0.041, H: 0.14})
+	_, err = GappedParams(matrix.BLOSUM62, -1, -11)
+	c.Check(err, check.ErrorMatches, "align: no gapped parameters for BLOSUM62 with gap costs -1/-11")
+
+	// Matrices are identified by their letter scores, not their gap scores.
+	for _, test := range []struct {
+		m            Linear
+		open, extend int
+		want         KarlinAltschul
+	}{
+		{m: matrix.BLOSUM45, open: -13, extend: -3, want: KarlinAltschul{Lambda: 0.207, K: 0.049, H: 0.14}},
+		{m: matrix.BLOSUM50, open: -13, extend: -3, want: KarlinAltschul{Lambda: 0.212, K: 0.063, H: 0.19}},
+		{m: matrix.BLOSUM90, open: -8, extend: -2, want: KarlinAltschul{Lambda: 0.300, K: 0.099, H: 0.39}},
+		{m: matrix.PAM30, open: -9, extend: -1, want: KarlinAltschul{Lambda: 0.294, K: 0.11, H: 0.61}},
+	} {
+		gapped := make(Linear, len(test.m))
+		for i, row := range test.m {
+			gapped[i] = append([]int(nil), row...)
+			gapped[i][0], gapped[0][i] = -5, -5
+		}
+		for _, m := range []Linear{test.m, gapped} {
+			got, err := GappedParams(m, test.open, test.extend)
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.Equals, test.want)
+		}
+	}
+	custom := make(Linear, len(matrix.BLOSUM62))
+	for i, row := range matrix.BLOSUM62 {
+		custom[i] = append([]int(nil), row...)
+	}
+	custom[1][1]++
+	_, err = GappedParams(custom, -11, -1)
+	c.Check(err, check.ErrorMatches, "align: no gapped parameters for scoring matrix")
+	_, err = GappedParams(matrix.NUC_4, -11, -1)
+	c.Check(err, check.ErrorMatches, "align: no gapped parameters for scoring matrix")
+
+	bits := p.BitScore(100)
+	c.Check(math.Abs(bits-43.12) < 0.01, check.Equals, true, check.Commentf("bits %v", bits))
+	e := p.EValue(100, 250, 1e6)
+	c.Check(math.Abs(e-250*1e6*math.Pow(2, -bits)) < 1e-9, check.Equals, true)
+	c.Check(math.Abs(p.PValue(100, 250, 1e6)-(1-math.Exp(-e))) < 1e-12, check.Equals, true)
+
+	effM, effN := p.EffectiveLengths(250, 1e6, 100)
+	c.Check(effM < 250 && effM > 100, check.Equals, true, check.Commentf("effective query length %d", effM))
+	c.Check(effN < 1e6 && effN > 9e5, check.Equals, true, check.Commentf("effective database length %d", effN))
+}
+
+func BenchmarkBatchSWAffine(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func() *linear.Seq {
+		l := make([]byte, 500)
+		for i := range l {
+			l[i] = "acgt"[rnd.Intn(4)]
+		}
+		s := &linear.Seq{Seq: alphabet.BytesToLetters(l)}
+		s.Alpha = alphabet.DNAgapped
+		return s
+	}
+	jobs := make([]Job, 64)
+	for i := range jobs {
+		jobs[i] = Job{Reference: randSeq(), Query: randSeq()}
+	}
+	batch := Batch{Aligner: SWAffine{Matrix: Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 2},
+	}, GapOpen: -3}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch.AlignAll(jobs)
+	}
+}
+
 func BenchmarkSWAlign(b *testing.B) {
 	t := &linear.Seq{}
 	t.Alpha = alphabet.DNAgapped
@@ -70,6 +900,26 @@ func BenchmarkSWAlign(b *testing.B) {
 	}
 }
 
+func BenchmarkSWScore(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	swsa, _ := r.Read()
+	swsb, _ := r.Read()
+
+	smith := SW{
+		{2, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 0},
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		smith.Score(swsa, swsb)
+	}
+}
+
 func BenchmarkNWAlign(b *testing.B) {
 	t := &linear.Seq{}
 	t.Alpha = alphabet.DNAgapped
@@ -137,3 +987,235 @@ func BenchmarkNWAffineAlign(b *testing.B) {
 		needle.Align(nwsa, nwsb)
 	}
 }
+
+func BenchmarkSWBandedAlign(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	swsa, _ := r.Read()
+	swsb, _ := r.Read()
+
+	smith := SWBanded{
+		Matrix: Linear{
+			{2, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 0},
+		},
+		Width: 50,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		smith.Align(swsa, swsb)
+	}
+}
+
+func BenchmarkNWBandedAlign(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	nwsa, _ := r.Read()
+	nwsb, _ := r.Read()
+
+	needle := NWBanded{
+		Matrix: Linear{
+			{10, -3, -1, -4, -5},
+			{-3, 9, -5, 0, -5},
+			{-1, -5, 7, -3, -5},
+			{-4, 0, -3, 8, -5},
+			{-4, -4, -4, -4, 0},
+		},
+		Width:  50,
+		Centre: nwsb.Len() - nwsa.Len(),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		needle.Align(nwsa, nwsb)
+	}
+}
+
+func BenchmarkHirschbergAlign(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	nwsa, _ := r.Read()
+	nwsb, _ := r.Read()
+
+	needle := Hirschberg{
+		{10, -3, -1, -4, -5},
+		{-3, 9, -5, 0, -5},
+		{-1, -5, 7, -3, -5},
+		{-4, 0, -3, 8, -5},
+		{-4, -4, -4, -4, 0},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		needle.Align(nwsa, nwsb)
+	}
+}
+
+func BenchmarkHirschbergAffineAlign(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	nwsa, _ := r.Read()
+	nwsb, _ := r.Read()
+
+	needle := HirschbergAffine{
+		Matrix: Linear{
+			{10, -3, -1, -4, -5},
+			{-3, 9, -5, 0, -5},
+			{-1, -5, 7, -3, -5},
+			{-4, 0, -3, 8, -5},
+			{-4, -4, -4, -4, 0},
+		},
+		GapOpen: -10,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		needle.Align(nwsa, nwsb)
+	}
+}
+
+func BenchmarkSWAffineScore(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	swsa, _ := r.Read()
+	swsb, _ := r.Read()
+
+	smith := SWAffine{
+		Matrix: Linear{
+			{2, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 0},
+		},
+		GapOpen: -5,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		smith.Score(swsa, swsb)
+	}
+}
+
+func BenchmarkSWStripedAlign(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	swsa, _ := r.Read()
+	swsb, _ := r.Read()
+
+	smith := SWStriped{
+		Matrix: Linear{
+			{2, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 0},
+		},
+		GapOpen: -5,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		smith.Align(swsa, swsb)
+	}
+}
+
+func BenchmarkSWStripedScore(b *testing.B) {
+	t := &linear.Seq{}
+	t.Alpha = alphabet.DNAgapped
+	r := fasta.NewReader(strings.NewReader(crspFa), t)
+	swsa, _ := r.Read()
+	swsb, _ := r.Read()
+
+	smith := SWStriped{
+		Matrix: Linear{
+			{2, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 0},
+		},
+		GapOpen: -5,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		smith.Score(swsa, swsb)
+	}
+}
+
+func (s *S) TestProfile(c *check.C) {
+	newMulti := func(id string, rows ...string) *multi.Multi {
+		var sr []seq.Sequence
+		for i, r := range rows {
+			sr = append(sr, linear.NewSeq(fmt.Sprintf("%s%d", id, i), alphabet.BytesToLetters([]byte(r)), alphabet.DNAgapped))
+		}
+		m, err := multi.NewMulti(id, sr, seq.DefaultConsensus)
+		c.Assert(err, check.Equals, nil)
+		return m
+	}
+	format := func(m *multi.Multi) []string {
+		var f []string
+		for _, r := range m.Seq {
+			f = append(f, fmt.Sprintf("%-s", r))
+		}
+		return f
+	}
+
+	m := newMulti("a", "acgt-a", "acctta", "a-gtta", "acgt-a")
+	p, err := NewProfile(m, alphabet.DNAgapped)
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.Len(), check.Equals, 6)
+	c.Check(p.Freqs[2], check.DeepEquals, []float64{0, 0, 0.25, 0.75, 0})
+	c.Check(p.Occupancy(1), check.Equals, 0.75)
+	c.Check(p.Occupancy(4), check.Equals, 0.5)
+
+	pnw := ProfileNW{Matrix: matrix.NUC_4, GapOpen: -10, GapExtend: -2}
+	aln, err := pnw.AlignProfiles(p, p)
+	c.Assert(err, check.Equals, nil)
+	c.Check(fmt.Sprint(aln), check.Equals, "[[0,6)/[0,6)=21]")
+
+	// Gaps are cheaper opposite gappy columns.
+	q, err := NewProfile(newMulti("b", "acgta"), alphabet.DNAgapped)
+	c.Assert(err, check.Equals, nil)
+	aln, err = pnw.AlignProfiles(p, q)
+	c.Assert(err, check.Equals, nil)
+	c.Check(fmt.Sprint(aln), check.Equals, "[[0,4)/[0,4)=17 [4,5)/-=-6 [5,6)/[4,5)=5]")
+	c.Check(totalScore(aln), check.Equals, 16)
+
+	for _, t := range []struct {
+		a, b []string
+		want []string
+	}{
+		{
+			a:    []string{"acgtacgt", "acgtccgt"},
+			b:    []string{"acgtcgt"},
+			want: []string{"acgtacgt", "acgtccgt", "acgt-cgt"},
+		},
+		{
+			a:    []string{"ggacgtta", "ggacgtca"},
+			b:    []string{"acgtta", "acg-ta"},
+			want: []string{"ggacgtta", "ggacgtca", "--acgtta", "--acg-ta"},
+		},
+	} {
+		a, b := newMulti("a", t.a...), newMulti("b", t.b...)
+		got, err := pnw.AlignMulti(a, b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(format(got), check.DeepEquals, t.want)
+		c.Check(format(a), check.DeepEquals, t.a, check.Commentf("reference altered"))
+		c.Check(format(b), check.DeepEquals, t.b, check.Commentf("query altered"))
+	}
+
+	_, err = pnw.AlignProfiles(p, &Profile{Alpha: alphabet.Protein})
+	c.Check(err, check.ErrorMatches, "align: profile alphabets do not match")
+	_, err = NewProfile(&multi.Multi{}, alphabet.DNAgapped)
+	c.Check(err, check.ErrorMatches, "align: empty alignment")
+	_, err = NewProfile(newMulti("n", "acnt"), alphabet.DNAgapped)
+	c.Check(err, check.ErrorMatches, "align: illegal letter 'n' in column 2")
+}
@@ -0,0 +1,346 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// A ColumnType classifies a column of a pairwise alignment.
+type ColumnType byte
+
+const (
+	Match     ColumnType = iota // Reference and query letters are the same.
+	Mismatch                    // Reference and query letters differ.
+	Insertion                   // Query letter is aligned to a gap in the reference.
+	Deletion                    // Reference letter is aligned to a gap in the query.
+)
+
+func (t ColumnType) String() string {
+	switch t {
+	case Match:
+		return "match"
+	case Mismatch:
+		return "mismatch"
+	case Insertion:
+		return "insertion"
+	case Deletion:
+		return "deletion"
+	}
+	return fmt.Sprintf("ColumnType(%d)", t)
+}
+
+// A CigarOpType is a SAM CIGAR operation type.
+type CigarOpType byte
+
+const (
+	CigarMatch       CigarOpType = 'M' // Alignment match; the letters may be the same or differ.
+	CigarInsertion   CigarOpType = 'I' // Insertion to the reference.
+	CigarDeletion    CigarOpType = 'D' // Deletion from the reference.
+	CigarSoftClipped CigarOpType = 'S' // Query letters not included in the alignment.
+	CigarEqual       CigarOpType = '=' // Alignment match with the same letters.
+	CigarMismatch    CigarOpType = 'X' // Alignment match with different letters.
+)
+
+// consumes returns whether the operation consumes reference and query letters.
+func (t CigarOpType) consumes() (reference, query bool, ok bool) {
+	switch t {
+	case CigarMatch, CigarEqual, CigarMismatch:
+		return true, true, true
+	case CigarInsertion, CigarSoftClipped:
+		return false, true, true
+	case CigarDeletion:
+		return true, false, true
+	}
+	return false, false, false
+}
+
+// A CigarOp is a single SAM CIGAR operation.
+type CigarOp struct {
+	Type CigarOpType
+	Len  int
+}
+
+func (o CigarOp) String() string { return strconv.Itoa(o.Len) + string(o.Type) }
+
+// A Cigar is a SAM CIGAR alignment description.
+type Cigar []CigarOp
+
+// String returns the SAM text representation of the CIGAR. An empty CIGAR is represented
+// by "*".
+func (c Cigar) String() string {
+	if len(c) == 0 {
+		return "*"
+	}
+	var buf bytes.Buffer
+	for _, o := range c {
+		buf.WriteString(strconv.Itoa(o.Len))
+		buf.WriteByte(byte(o.Type))
+	}
+	return buf.String()
+}
+
+// ParseCigar returns the Cigar described by the SAM text representation in s. The
+// operations M, I, D, S, = and X are accepted.
+func ParseCigar(s string) (Cigar, error) {
+	if s == "*" {
+		return nil, nil
+	}
+	var c Cigar
+	n := -1
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if '0' <= b && b <= '9' {
+			j := i
+			for ; j < len(s) && '0' <= s[j] && s[j] <= '9'; j++ {
+			}
+			var err error
+			n, err = strconv.Atoi(s[i:j])
+			if err != nil {
+				return nil, fmt.Errorf("align: invalid cigar length %q: %v", s[i:j], err)
+			}
+			i = j - 1
+			continue
+		}
+		if _, _, ok := CigarOpType(b).consumes(); !ok {
+			return nil, fmt.Errorf("align: invalid cigar operation %q at position %d", b, i)
+		}
+		switch n {
+		case -1:
+			return nil, fmt.Errorf("align: missing cigar length at position %d", i)
+		case 0:
+			return nil, fmt.Errorf("align: zero cigar length at position %d", i)
+		}
+		c = append(c, CigarOp{Type: CigarOpType(b), Len: n})
+		n = -1
+	}
+	if n != -1 {
+		return nil, fmt.Errorf("align: cigar %q ends with a length", s)
+	}
+	return c, nil
+}
+
+// An Alignment is a pairwise alignment of a reference and a query sequence. It provides
+// summaries of an alignment description returned by an Aligner.
+type Alignment struct {
+	pairs []feat.Pair
+
+	rSeq, qSeq []int
+
+	// cols holds the classification of each column
+	// of the alignment from the reference start.
+	cols []ColumnType
+
+	rStart, rEnd int
+	qStart, qEnd int
+}
+
+// NewAlignment returns an Alignment of reference and query described by pairs. The
+// feature pairs must be ordered and contiguous, and each pair must either have equal
+// length features or have one zero length feature, as returned by the Aligners in
+// this package. NewAlignment returns an error if the sequence data types or alphabets
+// do not match or if the feature pairs do not describe a valid alignment.
+func NewAlignment(reference, query AlphabetSlicer, pairs []feat.Pair) (*Alignment, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Alignment{pairs: pairs, rSeq: rSeq, qSeq: qSeq}
+	first := true
+	for _, fp := range pairs {
+		f := fp.Features()
+		rf, qf := f[0], f[1]
+		if rf.Len() == 0 && qf.Len() == 0 {
+			continue
+		}
+		if first {
+			a.rStart, a.rEnd = rf.Start(), rf.Start()
+			a.qStart, a.qEnd = qf.Start(), qf.Start()
+			first = false
+		}
+		switch {
+		case rf.Start() != a.rEnd || qf.Start() != a.qEnd:
+			return nil, fmt.Errorf("align: feature pair %v is not contiguous with previous pair", fp)
+		case rf.End() > len(rSeq) || qf.End() > len(qSeq):
+			return nil, fmt.Errorf("align: feature pair %v out of sequence range", fp)
+		case rf.Len() == 0:
+			for range qSeq[qf.Start():qf.End()] {
+				a.cols = append(a.cols, Insertion)
+			}
+		case qf.Len() == 0:
+			for range rSeq[rf.Start():rf.End()] {
+				a.cols = append(a.cols, Deletion)
+			}
+		case rf.Len() == qf.Len():
+			for k := 0; k < rf.Len(); k++ {
+				if rSeq[rf.Start()+k] == qSeq[qf.Start()+k] {
+					a.cols = append(a.cols, Match)
+				} else {
+					a.cols = append(a.cols, Mismatch)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("align: feature pair %v has unequal non-zero lengths", fp)
+		}
+		a.rEnd, a.qEnd = rf.End(), qf.End()
+	}
+
+	return a, nil
+}
+
+// FromCigar returns an Alignment of reference and query described by the CIGAR c with
+// the alignment starting at position pos of the reference. The scores of the feature
+// pairs of the returned Alignment are zero. FromCigar returns an error if the sequence
+// data types or alphabets do not match or if c does not describe a valid alignment of
+// the sequences.
+func FromCigar(reference, query AlphabetSlicer, pos int, c Cigar) (*Alignment, error) {
+	var (
+		pairs []feat.Pair
+
+		i, j = pos, 0
+	)
+	for n, o := range c {
+		r, q, ok := o.Type.consumes()
+		if !ok {
+			return nil, fmt.Errorf("align: invalid cigar operation %q", byte(o.Type))
+		}
+		if o.Len < 1 {
+			return nil, fmt.Errorf("align: invalid cigar operation length %d", o.Len)
+		}
+		if o.Type == CigarSoftClipped {
+			if n != 0 && n != len(c)-1 {
+				return nil, fmt.Errorf("align: soft clip within cigar at operation %d", n)
+			}
+			j += o.Len
+			continue
+		}
+		fp := &featPair{
+			a: feature{start: i, end: i},
+			b: feature{start: j, end: j},
+		}
+		if r {
+			i += o.Len
+			fp.a.end = i
+		}
+		if q {
+			j += o.Len
+			fp.b.end = j
+		}
+		if len(pairs) != 0 {
+			last := pairs[len(pairs)-1].(*featPair)
+			if last.a.Len() == last.b.Len() && fp.a.Len() == fp.b.Len() {
+				// Merge adjacent M, = and X operations.
+				last.a.end, last.b.end = fp.a.end, fp.b.end
+				continue
+			}
+		}
+		pairs = append(pairs, fp)
+	}
+	if pos < 0 || i > reference.Slice().Len() {
+		return nil, fmt.Errorf("align: cigar %v at %d out of reference range", c, pos)
+	}
+	if j != query.Slice().Len() {
+		return nil, fmt.Errorf("align: cigar %v query length %d does not match query length %d", c, j, query.Slice().Len())
+	}
+
+	return NewAlignment(reference, query, pairs)
+}
+
+// Pairs returns the feature pairs describing the alignment.
+func (a *Alignment) Pairs() []feat.Pair { return a.pairs }
+
+// Reference returns the start and end of the aligned region of the reference.
+func (a *Alignment) Reference() (start, end int) { return a.rStart, a.rEnd }
+
+// Query returns the start and end of the aligned region of the query.
+func (a *Alignment) Query() (start, end int) { return a.qStart, a.qEnd }
+
+// Columns returns the classification of each column of the alignment.
+func (a *Alignment) Columns() []ColumnType { return append([]ColumnType(nil), a.cols...) }
+
+// Len returns the number of columns in the alignment.
+func (a *Alignment) Len() int { return len(a.cols) }
+
+// Count returns the number of columns of the alignment with type t.
+func (a *Alignment) Count(t ColumnType) int {
+	var n int
+	for _, c := range a.cols {
+		if c == t {
+			n++
+		}
+	}
+	return n
+}
+
+// EditDistance returns the number of mismatched, inserted and deleted letters in the
+// alignment, the SAM NM value.
+func (a *Alignment) EditDistance() int { return a.Len() - a.Count(Match) }
+
+// Identity returns the fraction of the columns of the alignment that are matches. The
+// identity of an empty alignment is zero.
+func (a *Alignment) Identity() float64 {
+	if len(a.cols) == 0 {
+		return 0
+	}
+	return float64(a.Count(Match)) / float64(len(a.cols))
+}
+
+// Cigar returns the SAM CIGAR describing the alignment using M operations for aligned
+// letters. Unaligned query letters at the ends of the alignment are soft clipped.
+func (a *Alignment) Cigar() Cigar { return a.cigar(false) }
+
+// ExtendedCigar returns the SAM CIGAR describing the alignment using = and X operations
+// for matched and mismatched letters. Unaligned query letters at the ends of the alignment
+// are soft clipped.
+func (a *Alignment) ExtendedCigar() Cigar { return a.cigar(true) }
+
+func (a *Alignment) cigar(extended bool) Cigar {
+	var c Cigar
+	appendOp := func(t CigarOpType, n int) {
+		if n == 0 {
+			return
+		}
+		if len(c) != 0 && c[len(c)-1].Type == t {
+			c[len(c)-1].Len += n
+			return
+		}
+		c = append(c, CigarOp{Type: t, Len: n})
+	}
+	appendOp(CigarSoftClipped, a.qStart)
+	for _, col := range a.cols {
+		var t CigarOpType
+		switch col {
+		case Match:
+			t = CigarEqual
+			if !extended {
+				t = CigarMatch
+			}
+		case Mismatch:
+			t = CigarMismatch
+			if !extended {
+				t = CigarMatch
+			}
+		case Insertion:
+			t = CigarInsertion
+		case Deletion:
+			t = CigarDeletion
+		}
+		appendOp(t, 1)
+	}
+	appendOp(CigarSoftClipped, len(a.qSeq)-a.qEnd)
+	return c
+}
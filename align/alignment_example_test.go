@@ -0,0 +1,75 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleNewAlignment() {
+	swsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATAGGAAG"))}
+	swsa.Alpha = alphabet.DNAgapped
+	swsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATTGGCAATG"))}
+	swsb.Alpha = alphabet.DNAgapped
+
+	smith := SWAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		GapOpen: -5,
+	}
+
+	aln, err := smith.Align(swsa, swsb)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	a, err := NewAlignment(swsa, swsb, aln)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(a.Cigar())
+	fmt.Println(a.ExtendedCigar())
+	fmt.Printf("NM:%d identity:%.3f length:%d\n", a.EditDistance(), a.Identity(), a.Len())
+	fmt.Println(a.Columns())
+	// Output:
+	// 7M3S
+	// 2=1X2=1X1=3S
+	// NM:2 identity:0.714 length:7
+	// [match match mismatch match match mismatch match]
+}
+
+func ExampleFromCigar() {
+	ref := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GGATTACAGATTACA"))}
+	ref.Alpha = alphabet.DNAgapped
+	query := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ccATTCAGGATTtt"))}
+	query.Alpha = alphabet.DNAgapped
+
+	c, err := ParseCigar("2S3M1D3M1I3M2S")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	a, err := FromCigar(ref, query, 2, c)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(a.Pairs())
+	fmt.Println(a.ExtendedCigar())
+	fmt.Printf("NM:%d\n", a.EditDistance())
+	// Output:
+	// [[2,5)/[2,5)=0 [5,6)/-=0 [6,9)/[5,8)=0 -/[8,9)=0 [9,12)/[9,12)=0]
+	// 2S3=1D3=1I3=2S
+	// NM:2
+}
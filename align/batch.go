@@ -0,0 +1,191 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"runtime"
+	"sync"
+)
+
+// tables holds dynamic programming tables for reuse between alignments. A nil
+// *tables allocates a new table for each alignment.
+type tables struct {
+	lin []int
+	aff [][3]int
+}
+
+// linear returns a zeroed linear gap penalty table of length n.
+func (t *tables) linear(n int) []int {
+	if t == nil {
+		return make([]int, n)
+	}
+	if cap(t.lin) < n {
+		t.lin = make([]int, n)
+		return t.lin
+	}
+	t.lin = t.lin[:n]
+	for i := range t.lin {
+		t.lin[i] = 0
+	}
+	return t.lin
+}
+
+// affine returns a zeroed affine gap penalty table of length n.
+func (t *tables) affine(n int) [][3]int {
+	if t == nil {
+		return make([][3]int, n)
+	}
+	if cap(t.aff) < n {
+		t.aff = make([][3]int, n)
+		return t.aff
+	}
+	t.aff = t.aff[:n]
+	for i := range t.aff {
+		t.aff[i] = [3]int{}
+	}
+	return t.aff
+}
+
+// bufferedAligner is an Aligner that is able to reuse dynamic programming tables.
+type bufferedAligner interface {
+	Aligner
+	align(reference, query AlphabetSlicer, buf *tables) ([]feat.Pair, error)
+}
+
+var (
+	_ bufferedAligner = SW(nil)
+	_ bufferedAligner = NW(nil)
+	_ bufferedAligner = SWAffine{}
+	_ bufferedAligner = NWAffine{}
+	_ bufferedAligner = Fitted(nil)
+	_ bufferedAligner = FittedAffine{}
+	_ bufferedAligner = Overlap(nil)
+	_ bufferedAligner = OverlapAffine{}
+)
+
+// A Job is a pair of sequences to be aligned by a Batch.
+type Job struct {
+	Reference, Query AlphabetSlicer
+}
+
+// A Result is the result of a Job.
+type Result struct {
+	Pairs []feat.Pair
+	Err   error
+}
+
+// Batch is a concurrent batch aligner. Jobs are aligned by a pool of worker goroutines
+// using Aligner. When Aligner is an SW, NW, SWAffine, NWAffine, Fitted, FittedAffine,
+// Overlap or OverlapAffine, each worker reuses its dynamic programming table between
+// jobs, otherwise the Aligner's Align method is called for each job.
+type Batch struct {
+	Aligner Aligner
+
+	// Workers is the number of worker goroutines.
+	// If Workers is less than 1 or greater than
+	// GOMAXPROCS, GOMAXPROCS workers are used.
+	Workers int
+}
+
+// Align aligns each Job received from jobs and returns a channel on which the results are
+// sent in the order of the jobs. The returned channel is closed after jobs has been closed
+// and all the results have been sent. The number of jobs being held by the Batch is limited,
+// so results must be received for further jobs to be taken from jobs.
+func (b Batch) Align(jobs <-chan Job) <-chan Result {
+	workers := b.Workers
+	if available := runtime.GOMAXPROCS(0); workers > available || workers < 1 {
+		workers = available
+	}
+
+	type indexed struct {
+		index int
+		Job
+		Result
+	}
+	var (
+		// window limits the number of jobs held between
+		// being taken from jobs and sending their results.
+		window = make(chan struct{}, 4*workers)
+
+		in   = make(chan indexed)
+		done = make(chan indexed)
+		out  = make(chan Result)
+	)
+
+	go func() {
+		var n int
+		for j := range jobs {
+			window <- struct{}{}
+			in <- indexed{index: n, Job: j}
+			n++
+		}
+		close(in)
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var buf tables
+			for j := range in {
+				j.Pairs, j.Err = b.align(j.Reference, j.Query, &buf)
+				j.Job = Job{}
+				done <- j
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		defer close(out)
+		var next int
+		pending := make(map[int]Result)
+		for r := range done {
+			pending[r.index] = r.Result
+			for {
+				r, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				out <- r
+				<-window
+				next++
+			}
+		}
+	}()
+
+	return out
+}
+
+// AlignAll aligns each Job in jobs and returns the results in the order of the jobs.
+func (b Batch) AlignAll(jobs []Job) []Result {
+	in := make(chan Job)
+	go func() {
+		for _, j := range jobs {
+			in <- j
+		}
+		close(in)
+	}()
+	results := make([]Result, 0, len(jobs))
+	for r := range b.Align(in) {
+		results = append(results, r)
+	}
+	return results
+}
+
+// align aligns reference and query, using buf if the Batch's Aligner can make use of it.
+func (b Batch) align(reference, query AlphabetSlicer, buf *tables) ([]feat.Pair, error) {
+	if a, ok := b.Aligner.(bufferedAligner); ok {
+		return a.align(reference, query, buf)
+	}
+	return b.Aligner.Align(reference, query)
+}
@@ -0,0 +1,51 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleBatch_Align() {
+	reference := linear.NewSeq("reference", alphabet.BytesToLetters([]byte("ACACACTAGCTAGCTTAGCA")), alphabet.DNAgapped)
+	queries := []string{"AGCACACA", "TAGCTTAG", "GCTAGCAA"}
+
+	// w(gap) = -1
+	// w(match) = +2
+	// w(mismatch) = -1
+	batch := Batch{
+		Aligner: SW{
+			{0, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 2},
+		},
+		Workers: 2,
+	}
+
+	jobs := make(chan Job)
+	go func() {
+		for _, q := range queries {
+			query := linear.NewSeq("query", alphabet.BytesToLetters([]byte(q)), alphabet.DNAgapped)
+			jobs <- Job{Reference: reference, Query: query}
+		}
+		close(jobs)
+	}()
+	for r := range batch.Align(jobs) {
+		if r.Err != nil {
+			fmt.Println(r.Err)
+			continue
+		}
+		fmt.Println(r.Pairs)
+	}
+	// Output:
+	// [[0,1)/[0,1)=2 -/[1,2)=-1 [1,6)/[2,7)=10 [6,7)/-=-1 [7,8)/[7,8)=2]
+	// [[10,18)/[0,8)=16]
+	// [[12,14)/[0,2)=4 [14,15)/-=-1 [15,20)/[2,7)=10]
+}
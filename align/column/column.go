@@ -0,0 +1,252 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package column provides column-level statistics, trimming and masking for multiple
+// sequence alignments.
+//
+// The statistics functions return a slice with an element for each column of the
+// alignment, with the first element describing the column at the alignment's Start
+// position. Rows that do not cover a column are treated as having a gap in that
+// column. Unless otherwise stated, gaps, the ambiguous letter of the alignment's
+// alphabet and letters that are not valid in the alphabet are treated as missing
+// data and are not counted as residues.
+package column
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"math"
+)
+
+// An Alignment is a multiple alignment, such as a *multi.Multi or an *alignment.Seq.
+type Alignment interface {
+	seq.Aligned
+	Alphabet() alphabet.Alphabet
+}
+
+// counts returns the number of rows of a and the counts of each residue, indexed by
+// letter index, in each column of a.
+func counts(a Alignment) (rows int, n [][]int) {
+	alpha := a.Alphabet()
+	index := alpha.LetterIndex()
+	gap, ambig := index[alpha.Gap()], alpha.IndexOf(alpha.Ambiguous())
+	n = make([][]int, 0, a.End()-a.Start())
+	for pos := a.Start(); pos < a.End(); pos++ {
+		col := a.Column(pos, true)
+		rows = len(col)
+		c := make([]int, alpha.Len())
+		for _, l := range col {
+			if i := index[l]; i >= 0 && i != gap && i != ambig {
+				c[i]++
+			}
+		}
+		n = append(n, c)
+	}
+	return rows, n
+}
+
+// residues returns the number of letters of alpha that are counted as residues.
+func residues(alpha alphabet.Alphabet) int {
+	k := alpha.Len()
+	if alpha.IndexOf(alpha.Gap()) >= 0 {
+		k--
+	}
+	if alpha.IndexOf(alpha.Ambiguous()) >= 0 {
+		k--
+	}
+	return k
+}
+
+// entropy returns the Shannon entropy in bits of the residue counts in c and the total
+// number of residues.
+func entropy(c []int) (h float64, n int) {
+	for _, v := range c {
+		n += v
+	}
+	for _, v := range c {
+		if v != 0 {
+			p := float64(v) / float64(n)
+			h -= p * math.Log2(p)
+		}
+	}
+	return h, n
+}
+
+// Entropy returns the Shannon entropy in bits of the residue frequencies in each column
+// of a. Columns without residues have an entropy of zero.
+func Entropy(a Alignment) []float64 {
+	_, n := counts(a)
+	h := make([]float64, len(n))
+	for i, c := range n {
+		h[i], _ = entropy(c)
+	}
+	return h
+}
+
+// Conservation returns a conservation score for each column of a. The score is
+// (1 - H/log₂K) × f, where H is the entropy of the column, K is the number of residue
+// letters in the alphabet of a and f is the fraction of rows with a residue in the
+// column. Scores range from zero for columns without residues or with all residues
+// equally represented to one for invariant columns without missing data.
+func Conservation(a Alignment) []float64 {
+	rows, n := counts(a)
+	maxH := math.Log2(float64(residues(a.Alphabet())))
+	s := make([]float64, len(n))
+	for i, c := range n {
+		h, k := entropy(c)
+		if k == 0 {
+			continue
+		}
+		s[i] = float64(k) / float64(rows)
+		if maxH > 0 {
+			s[i] *= 1 - h/maxH
+		}
+	}
+	return s
+}
+
+// GapFraction returns the fraction of rows with a gap in each column of a. Ambiguous and
+// invalid letters are not counted as gaps.
+func GapFraction(a Alignment) []float64 {
+	gap := a.Alphabet().Gap()
+	f := make([]float64, 0, a.End()-a.Start())
+	for pos := a.Start(); pos < a.End(); pos++ {
+		col := a.Column(pos, true)
+		var n int
+		for _, l := range col {
+			if l == gap {
+				n++
+			}
+		}
+		f = append(f, float64(n)/float64(len(col)))
+	}
+	return f
+}
+
+// SumOfPairs returns the sum-of-pairs score of each column of a under the scoring matrix
+// m. Each pair of rows i < j in a column contributes m[x_i][x_j], where x_i and x_j are
+// the letter indices of the rows' letters, so pairs involving a gap are scored by the
+// gap penalties in the first row and column of m. Pairs of gaps score zero. SumOfPairs
+// returns an error if m is not square and large enough for the alphabet of a, or if a
+// holds a letter that is not valid in the alphabet.
+func SumOfPairs(a Alignment, m align.Linear) ([]int, error) {
+	alpha := a.Alphabet()
+	if len(m) < alpha.Len() {
+		return nil, align.ErrMatrixWrongSize{Size: len(m), Len: alpha.Len()}
+	}
+	for _, row := range m {
+		if len(row) != len(m) {
+			return nil, align.ErrMatrixNotSquare
+		}
+	}
+	index := alpha.LetterIndex()
+	gap := index[alpha.Gap()]
+	s := make([]int, 0, a.End()-a.Start())
+	idx := make([]int, 0, a.Rows())
+	for pos := a.Start(); pos < a.End(); pos++ {
+		idx = idx[:0]
+		for _, l := range a.Column(pos, true) {
+			i := index[l]
+			if i < 0 {
+				return nil, fmt.Errorf("column: illegal letter %q in column %d", l, pos)
+			}
+			idx = append(idx, i)
+		}
+		var sp int
+		for k, i := range idx {
+			for _, j := range idx[k+1:] {
+				if i != gap || j != gap {
+					sp += m[i][j]
+				}
+			}
+		}
+		s = append(s, sp)
+	}
+	return s, nil
+}
+
+// Segregating returns whether each column of a holds more than one distinct residue.
+func Segregating(a Alignment) []bool {
+	_, n := counts(a)
+	s := make([]bool, len(n))
+	for i, c := range n {
+		var k int
+		for _, v := range c {
+			if v != 0 {
+				k++
+			}
+		}
+		s[i] = k > 1
+	}
+	return s
+}
+
+// ParsimonyInformative returns whether each column of a is parsimony informative, holding
+// at least two distinct residues that each occur in at least two rows.
+func ParsimonyInformative(a Alignment) []bool {
+	_, n := counts(a)
+	s := make([]bool, len(n))
+	for i, c := range n {
+		var k int
+		for _, v := range c {
+			if v > 1 {
+				k++
+			}
+		}
+		s[i] = k > 1
+	}
+	return s
+}
+
+// A Block is a half-open range of alignment columns. It satisfies feat.Feature.
+type Block struct {
+	From, To int
+}
+
+func (b Block) Start() int             { return b.From }
+func (b Block) End() int               { return b.To }
+func (b Block) Len() int               { return b.To - b.From }
+func (b Block) Name() string           { return "" }
+func (b Block) Description() string    { return "" }
+func (b Block) Location() feat.Feature { return nil }
+
+// Blocks is a set of alignment column blocks. It satisfies feat.Set.
+type Blocks []Block
+
+// Features returns the blocks as a slice of feat.Feature.
+func (b Blocks) Features() []feat.Feature {
+	f := make([]feat.Feature, len(b))
+	for i, blk := range b {
+		f[i] = blk
+	}
+	return f
+}
+
+// Select returns the blocks of consecutive columns of a for which keep is true, where keep
+// is indexed in the same way as the results of the statistics functions. The returned
+// Blocks are in alignment coordinates and may be passed to the Stitch or Compose methods
+// of a *multi.Multi to retain only the selected columns. Select panics if the length of
+// keep does not match the number of columns of a.
+func Select(a seq.Aligned, keep []bool) Blocks {
+	if len(keep) != a.End()-a.Start() {
+		panic("column: keep length does not match alignment length")
+	}
+	var b Blocks
+	for i := 0; i < len(keep); i++ {
+		if !keep[i] {
+			continue
+		}
+		j := i
+		for j < len(keep) && keep[j] {
+			j++
+		}
+		b = append(b, Block{From: a.Start() + i, To: a.Start() + j})
+		i = j
+	}
+	return b
+}
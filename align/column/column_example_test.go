@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package column_test
+
+import (
+	"github.com/biogo/biogo/align/column"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"fmt"
+)
+
+func ExampleSelect() {
+	var rows []seq.Sequence
+	for _, s := range []struct{ id, seq string }{
+		{"a", "acgtacgtac"},
+		{"b", "acgaacgtgc"},
+		{"c", "tcgaacctgc"},
+		{"d", "tcgtacc-ac"},
+	} {
+		rows = append(rows, linear.NewSeq(s.id, alphabet.BytesToLetters([]byte(s.seq)), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("example", rows, seq.DefaultConsensus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Retain only the parsimony informative sites.
+	informative := column.ParsimonyInformative(m)
+	blocks := column.Select(m, informative)
+	fmt.Println(blocks)
+	err = m.Stitch(blocks)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%a\n", m)
+	// Output:
+	// [{0 1} {3 4} {6 7} {8 9}]
+	// >a
+	// atga
+	// >b
+	// aagg
+	// >c
+	// tacg
+	// >d
+	// ttca
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package column
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/alignment"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func newMulti(c *check.C, rows ...string) *multi.Multi {
+	var sr []seq.Sequence
+	for i, r := range rows {
+		sr = append(sr, linear.NewSeq(fmt.Sprint(i), alphabet.BytesToLetters([]byte(r)), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("test", sr, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestStatistics(c *check.C) {
+	// Columns:  invariant, singleton, informative, gappy, all different, all gaps.
+	m := newMulti(c,
+		"aaaca-",
+		"aaa-c-",
+		"acc-g-",
+		"aac-t-",
+	)
+
+	h := Entropy(m)
+	c.Check(h, check.DeepEquals, []float64{0, 0.8112781244591328, 1, 0, 2, 0})
+
+	cons := Conservation(m)
+	c.Check(cons[0], check.Equals, 1.0)
+	c.Check(math.Abs(cons[1]-(1-0.8112781244591328/2)) < 1e-15, check.Equals, true)
+	c.Check(cons[2], check.Equals, 0.5)
+	c.Check(cons[3], check.Equals, 0.25)
+	c.Check(cons[4], check.Equals, 0.0)
+	c.Check(cons[5], check.Equals, 0.0)
+
+	c.Check(GapFraction(m), check.DeepEquals, []float64{0, 0, 0, 0.75, 0, 1})
+	c.Check(Segregating(m), check.DeepEquals, []bool{false, true, true, false, true, false})
+	c.Check(ParsimonyInformative(m), check.DeepEquals, []bool{false, false, true, false, false, false})
+
+	// Match 1, mismatch -1 and gap -2.
+	mat := align.Linear{
+		{0, -2, -2, -2, -2},
+		{-2, 1, -1, -1, -1},
+		{-2, -1, 1, -1, -1},
+		{-2, -1, -1, 1, -1},
+		{-2, -1, -1, -1, 1},
+	}
+	sp, err := SumOfPairs(m, mat)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sp, check.DeepEquals, []int{6, 0, -2, -6, -6, 0})
+
+	_, err = SumOfPairs(m, mat[:4])
+	c.Check(err, check.ErrorMatches, "align: scoring matrix size 4 does not match alphabet length 5")
+	_, err = SumOfPairs(newMulti(c, "an", "aa"), mat)
+	c.Check(err, check.ErrorMatches, "column: illegal letter 'n' in column 1")
+}
+
+func (s *S) TestAlignmentSeq(c *check.C) {
+	a, err := alignment.NewSeq("test", []string{"0", "1", "2"}, [][]alphabet.Letter{
+		[]alphabet.Letter("aaa"),
+		[]alphabet.Letter("ac-"),
+	}, alphabet.DNAgapped, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	c.Check(GapFraction(a), check.DeepEquals, []float64{0, 1.0 / 3})
+	c.Check(Entropy(a), check.DeepEquals, []float64{0, 1})
+}
+
+func (s *S) TestSelect(c *check.C) {
+	m := newMulti(c,
+		"aaacgtta",
+		"aa-cgtca",
+		"aa-cg--a",
+	)
+	gaps := GapFraction(m)
+	keep := make([]bool, len(gaps))
+	for i, f := range gaps {
+		keep[i] = f == 0
+	}
+	b := Select(m, keep)
+	c.Check(b, check.DeepEquals, Blocks{{From: 0, To: 2}, {From: 3, To: 5}, {From: 7, To: 8}})
+	c.Check(len(b.Features()), check.Equals, 3)
+	c.Check(b[1].Len(), check.Equals, 2)
+
+	c.Assert(m.Stitch(b), check.Equals, nil)
+	var rows []string
+	for _, r := range m.Seq {
+		rows = append(rows, fmt.Sprintf("%-s", r))
+	}
+	c.Check(rows, check.DeepEquals, []string{"aacga", "aacga", "aacga"})
+
+	c.Check(func() { Select(m, keep) }, check.Panics, "column: keep length does not match alignment length")
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package column
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+)
+
+// Trim is a column filter for removing gappy and poorly aligned columns from a multiple
+// alignment before further analysis, in the style of trimAl and Gblocks. A column is
+// retained if it passes both the gap and conservation thresholds and lies within a block
+// of at least MinBlock consecutive passing columns.
+type Trim struct {
+	// MaxGap is the maximum fraction of rows with a
+	// gap in a retained column.
+	MaxGap float64
+
+	// MinConservation is the minimum Conservation
+	// score of a retained column.
+	MinConservation float64
+
+	// MinBlock is the minimum length of a block of
+	// retained columns. Values less than one are
+	// treated as one.
+	MinBlock int
+}
+
+// Keep returns whether each column of a is retained by the filter, indexed in the same way as
+// the results of the statistics functions.
+func (t Trim) Keep(a Alignment) []bool {
+	gaps := GapFraction(a)
+	cons := Conservation(a)
+	keep := make([]bool, len(gaps))
+	for i := range keep {
+		keep[i] = gaps[i] <= t.MaxGap && cons[i] >= t.MinConservation
+	}
+	for i := 0; i < len(keep); i++ {
+		if !keep[i] {
+			continue
+		}
+		j := i
+		for j < len(keep) && keep[j] {
+			j++
+		}
+		if j-i < t.MinBlock {
+			for k := i; k < j; k++ {
+				keep[k] = false
+			}
+		}
+		i = j
+	}
+	return keep
+}
+
+// Blocks returns the blocks of columns of a retained by the filter. The returned Blocks may be
+// passed to the Stitch or Compose methods of a *multi.Multi to trim the alignment.
+func (t Trim) Blocks(a Alignment) Blocks {
+	return Select(a, t.Keep(a))
+}
+
+// A Masker is a multiple alignment whose rows can be altered, such as a *multi.Multi or an
+// *alignment.Seq.
+type Masker interface {
+	Alignment
+	seq.Rower
+}
+
+// Mask replaces the letters in each column of a for which keep is false with the letter l,
+// retaining quality scores. Gaps are not replaced. The keep slice is indexed in the same way
+// as the results of the statistics functions, so the result of Trim.Keep may be used to mask
+// the columns that would be removed by trimming.
+func Mask(a Masker, keep []bool, l alphabet.Letter) error {
+	if len(keep) != a.End()-a.Start() {
+		return errors.New("column: keep length does not match alignment length")
+	}
+	gap := a.Alphabet().Gap()
+	for i := 0; i < a.Rows(); i++ {
+		r := a.Row(i)
+		start, end := r.Start(), r.End()
+		if start < a.Start() {
+			start = a.Start()
+		}
+		if end > a.End() {
+			end = a.End()
+		}
+		for pos := start; pos < end; pos++ {
+			if keep[pos-a.Start()] {
+				continue
+			}
+			ql := r.At(pos)
+			if ql.L == gap {
+				continue
+			}
+			ql.L = l
+			err := r.Set(pos, ql)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package column
+
+import (
+	"fmt"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/alignment"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestTrim(c *check.C) {
+	m := newMulti(c,
+		"acgtaacgt-tacgt",
+		"acgtcacgtg-acgt",
+		"acgtgacgt--acgt",
+		"acgttacgtg-acgt",
+	)
+	for _, t := range []struct {
+		trim Trim
+		want Blocks
+	}{
+		{
+			trim: Trim{MaxGap: 1},
+			want: Blocks{{From: 0, To: 15}},
+		},
+		{
+			trim: Trim{MaxGap: 0.5},
+			want: Blocks{{From: 0, To: 10}, {From: 11, To: 15}},
+		},
+		{
+			trim: Trim{MaxGap: 0},
+			want: Blocks{{From: 0, To: 9}, {From: 11, To: 15}},
+		},
+		{
+			trim: Trim{MaxGap: 0, MinConservation: 0.5},
+			want: Blocks{{From: 0, To: 4}, {From: 5, To: 9}, {From: 11, To: 15}},
+		},
+		{
+			trim: Trim{MaxGap: 0, MinConservation: 0.5, MinBlock: 5},
+			want: nil,
+		},
+		{
+			trim: Trim{MaxGap: 0.5, MinBlock: 5},
+			want: Blocks{{From: 0, To: 10}},
+		},
+	} {
+		c.Check(t.trim.Blocks(m), check.DeepEquals, t.want, check.Commentf("%+v", t.trim))
+	}
+
+	tm := newMulti(c,
+		"acgtaacgt-tacgt",
+		"acgtcacgt--acgt",
+	)
+	c.Assert(tm.Stitch(Trim{MaxGap: 0}.Blocks(tm)), check.Equals, nil)
+	var rows []string
+	for _, r := range tm.Seq {
+		rows = append(rows, fmt.Sprintf("%-s", r))
+	}
+	c.Check(rows, check.DeepEquals, []string{"acgtaacgtacgt", "acgtcacgtacgt"})
+}
+
+func (s *S) TestMask(c *check.C) {
+	m := newMulti(c,
+		"acgtaacg-",
+		"acgtcac--",
+	)
+	keep := Trim{MaxGap: 0, MinConservation: 0.6}.Keep(m)
+	c.Check(keep, check.DeepEquals, []bool{true, true, true, true, false, true, true, false, false})
+	c.Assert(Mask(m, keep, 'n'), check.Equals, nil)
+	var rows []string
+	for _, r := range m.Seq {
+		rows = append(rows, fmt.Sprintf("%-s", r))
+	}
+	c.Check(rows, check.DeepEquals, []string{"acgtnacn-", "acgtnac--"})
+
+	a, err := alignment.NewSeq("test", []string{"0", "1"}, [][]alphabet.Letter{
+		[]alphabet.Letter("aa"),
+		[]alphabet.Letter("ac"),
+		[]alphabet.Letter("g-"),
+	}, alphabet.DNAgapped, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(Mask(a, []bool{true, false, false}, 'n'), check.Equals, nil)
+	c.Check(a.Seq, check.DeepEquals, alphabet.Columns{
+		[]alphabet.Letter("aa"),
+		[]alphabet.Letter("nn"),
+		[]alphabet.Letter("n-"),
+	})
+
+	c.Check(Mask(a, []bool{true}, 'n'), check.ErrorMatches, "column: keep length does not match alignment length")
+}
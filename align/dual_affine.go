@@ -0,0 +1,255 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// A DualAffine is a two-piece affine gap penalty alignment description. A gap of
+// length k is scored as the greater of the short gap score, GapOpen plus the sum of
+// the gap penalties of the gapped letters given by the first row and column of
+// Matrix, and the long gap score, LongGapOpen + k×LongGapExtend. Typically the long
+// gap piece has a more severe opening penalty and a less severe extension penalty
+// than the short gap piece so that long gaps are not over-penalised.
+type DualAffine struct {
+	Matrix  Linear
+	GapOpen int
+
+	LongGapOpen   int
+	LongGapExtend int
+}
+
+// NWDualAffine is the two-piece affine gap penalty Needleman-Wunsch aligner type.
+// As in NWAffine, an insertion may not be directly followed by a deletion or a
+// deletion by an insertion.
+type NWDualAffine DualAffine
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm. It returns an alignment description
+// or an error if the scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a NWDualAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	d, err := newDual(DualAffine(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return d.align(false), nil
+}
+
+// SWDualAffine is the two-piece affine gap penalty Smith-Waterman aligner type.
+type SWDualAffine DualAffine
+
+// Align aligns two sequences using the Smith-Waterman algorithm. It returns an alignment description
+// or an error if the scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a SWDualAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	d, err := newDual(DualAffine(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return d.align(true), nil
+}
+
+// Layers of the dual affine dynamic programming table in addition to
+// diag, up and left, which hold the short gap piece scores.
+const (
+	longUp = iota + left + 1
+	longLeft
+)
+
+// dual holds the state for a two-piece affine gap penalty alignment.
+type dual struct {
+	la  []int
+	let int
+
+	open           int
+	longOpen, long int
+
+	rSeq, qSeq []int
+}
+
+func newDual(a DualAffine, reference, query AlphabetSlicer) (*dual, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	la, let, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+	return &dual{
+		la: la, let: let,
+		open: a.GapOpen, longOpen: a.LongGapOpen, long: a.LongGapExtend,
+		rSeq: rSeq, qSeq: qSeq,
+	}, nil
+}
+
+// align returns the local or global alignment of the sequences.
+func (d *dual) align(local bool) []feat.Pair {
+	la, let := d.la, d.let
+	r, c := len(d.rSeq)+1, len(d.qSeq)+1
+	table := make([][5]int, r*c)
+
+	none := minInt
+	if local {
+		none = 0
+	}
+	maxS, maxI, maxJ := 0, 0, 0
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			p := i*c + j
+			score := [5]int{none, none, none, none, none}
+			if i == 0 && j == 0 {
+				score[diag] = 0
+				table[p] = score
+				continue
+			}
+			if i > 0 && j > 0 {
+				prev := table[p-c-1]
+				s := max2(max3(prev[diag], prev[up], prev[left]), max2(prev[longUp], prev[longLeft]))
+				score[diag] = add(s, la[d.rSeq[i-1]*let+d.qSeq[j-1]])
+				if local && score[diag] > 0 && score[diag] >= maxS && s == prev[diag] {
+					maxS, maxI, maxJ = score[diag], i, j
+				}
+			}
+			if i > 0 {
+				g := la[d.rSeq[i-1]*let]
+				score[up] = add(max2(add(table[p-c][diag], d.open), table[p-c][up]), g)
+				score[longUp] = add(max2(add(table[p-c][diag], d.longOpen), table[p-c][longUp]), d.long)
+			}
+			if j > 0 {
+				g := la[d.qSeq[j-1]]
+				score[left] = add(max2(add(table[p-1][diag], d.open), table[p-1][left]), g)
+				score[longLeft] = add(max2(add(table[p-1][diag], d.longOpen), table[p-1][longLeft]), d.long)
+			}
+			if local {
+				for l, v := range score {
+					score[l] = max2(v, 0)
+				}
+			}
+			table[p] = score
+		}
+	}
+
+	layer := diag
+	if !local {
+		maxI, maxJ = r-1, c-1
+		t := table[maxI*c+maxJ]
+		for l, s := range t[1:] {
+			if s > t[layer] {
+				layer = l + 1
+			}
+		}
+	}
+
+	var moves []byte
+	i, j := maxI, maxJ
+	for i > 0 || j > 0 {
+		p := i*c + j
+		score := table[p][layer]
+		if local && score == 0 {
+			break
+		}
+		switch layer {
+		case diag:
+			moves = append(moves, diag)
+			s := la[d.rSeq[i-1]*let+d.qSeq[j-1]]
+			prev := table[p-c-1]
+			layer = -1
+			for _, l := range []int{up, left, longUp, longLeft, diag} {
+				if score == add(prev[l], s) {
+					layer = l
+					break
+				}
+			}
+			i--
+			j--
+		case up, longUp:
+			moves = append(moves, up)
+			open, g := d.open, la[d.rSeq[i-1]*let]
+			if layer == longUp {
+				open, g = d.longOpen, d.long
+			}
+			switch score {
+			case add(table[p-c][layer], g):
+			case add(table[p-c][diag], open+g):
+				layer = diag
+			default:
+				layer = -1
+			}
+			i--
+		case left, longLeft:
+			moves = append(moves, left)
+			open, g := d.open, la[d.qSeq[j-1]]
+			if layer == longLeft {
+				open, g = d.longOpen, d.long
+			}
+			switch score {
+			case add(table[p-1][layer], g):
+			case add(table[p-1][diag], open+g):
+				layer = diag
+			default:
+				layer = -1
+			}
+			j--
+		}
+		if layer < 0 {
+			panic(fmt.Sprintf("align: dual affine internal error: no path at row: %d col:%d\n", i, j))
+		}
+	}
+	reverseMoves(moves)
+
+	return d.pairs(moves, i, j)
+}
+
+// pairs returns the feature pairs described by the alignment path in moves starting
+// from (i, j). Each gap is scored by the better of the short and long gap pieces.
+func (d *dual) pairs(moves []byte, i, j int) []feat.Pair {
+	var aln []feat.Pair
+	for n := 0; n < len(moves); {
+		m := moves[n]
+		fp := &featPair{
+			a: feature{start: i, end: i},
+			b: feature{start: j, end: j},
+		}
+		var k, short int
+		for ; n < len(moves) && moves[n] == m; n, k = n+1, k+1 {
+			switch m {
+			case diag:
+				fp.score += d.la[d.rSeq[i]*d.let+d.qSeq[j]]
+				i++
+				j++
+			case up:
+				short += d.la[d.rSeq[i]*d.let]
+				i++
+			case left:
+				short += d.la[d.qSeq[j]]
+				j++
+			}
+		}
+		if m != diag {
+			fp.score = max2(d.open+short, d.longOpen+k*d.long)
+		}
+		fp.a.end, fp.b.end = i, j
+		aln = append(aln, fp)
+	}
+	if len(aln) == 0 {
+		aln = append(aln, &featPair{
+			a: feature{start: i, end: i},
+			b: feature{start: j, end: j},
+		})
+	}
+	return aln
+}
@@ -19,6 +19,11 @@ type FittedAffine Affine
 // the reference with high similarity to the query. It returns an alignment description or an error if
 // the scoring matrix is not square, or the sequence data types or alphabets do not match.
 func (a FittedAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	return a.align(reference, query, nil)
+}
+
+// align aligns two sequences using buf to hold the dynamic programming table.
+func (a FittedAffine) align(reference, query AlphabetSlicer, buf *tables) ([]feat.Pair, error) {
 	alpha := reference.Alphabet()
 	if alpha == nil {
 		return nil, ErrNoAlphabet
@@ -35,13 +40,13 @@ func (a FittedAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignLetters(rSeq, qSeq, alpha)
+		return a.alignLetters(rSeq, qSeq, alpha, buf)
 	case alphabet.QLetters:
 		qSeq, ok := query.Slice().(alphabet.QLetters)
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignQLetters(rSeq, qSeq, alpha)
+		return a.alignQLetters(rSeq, qSeq, alpha, buf)
 	default:
 		return nil, ErrTypeNotHandled
 	}
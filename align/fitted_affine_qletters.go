@@ -90,7 +90,7 @@ func pointerFittedAffineQLetters(rSeq, qSeq alphabet.QLetters, i, j, l int, tabl
 	}
 }
 
-func (a FittedAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a FittedAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	la := make([]int, 0, let*let)
 	for _, row := range a.Matrix {
@@ -102,7 +102,7 @@ func (a FittedAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 	table[0] = [3]int{
 		diag: 0,
 		up:   minInt,
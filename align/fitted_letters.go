@@ -67,7 +67,7 @@ func pointerFittedLetters(rSeq, qSeq alphabet.Letters, i, j int, table []int, in
 	}
 }
 
-func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a)
 	la := make([]int, 0, let*let)
 	for _, row := range a {
@@ -79,7 +79,7 @@ func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabe
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([]int, r*c)
+	table := buf.linear(r * c)
 	for j := range table[1:c] {
 		table[j+1] = table[j] + la[index[qSeq[j]]]
 	}
@@ -125,3 +125,83 @@ cat < nw_affine_type.got \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> nw_affine_qletters.go
+
+echo -e $WARNING\
+> sw_banded_letters.go
+cat < sw_banded_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawSWBandedTableType -> drawSWBandedTableLetters' \
+| gofmt -r 'pointerSWBandedType -> pointerSWBandedLetters' \
+>> sw_banded_letters.go
+
+echo -e $WARNING\
+> sw_banded_qletters.go
+cat < sw_banded_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawSWBandedTableType -> drawSWBandedTableQLetters' \
+| gofmt -r 'pointerSWBandedType -> pointerSWBandedQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> sw_banded_qletters.go
+
+echo -e $WARNING\
+> nw_banded_letters.go
+cat < nw_banded_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawNWBandedTableType -> drawNWBandedTableLetters' \
+| gofmt -r 'pointerNWBandedType -> pointerNWBandedLetters' \
+>> nw_banded_letters.go
+
+echo -e $WARNING\
+> nw_banded_qletters.go
+cat < nw_banded_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawNWBandedTableType -> drawNWBandedTableQLetters' \
+| gofmt -r 'pointerNWBandedType -> pointerNWBandedQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> nw_banded_qletters.go
+
+echo -e $WARNING\
+> overlap_letters.go
+cat < overlap_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawOverlapTableType -> drawOverlapTableLetters' \
+| gofmt -r 'pointerOverlapType -> pointerOverlapLetters' \
+>> overlap_letters.go
+
+echo -e $WARNING\
+> overlap_qletters.go
+cat < overlap_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawOverlapTableType -> drawOverlapTableQLetters' \
+| gofmt -r 'pointerOverlapType -> pointerOverlapQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> overlap_qletters.go
+
+echo -e $WARNING\
+> overlap_affine_letters.go
+cat < overlap_affine_type.got \
+| gofmt -r 'alignType -> alignLetters' \
+| gofmt -r 'Type -> alphabet.Letters' \
+| gofmt -r 'drawOverlapAffineTableType -> drawOverlapAffineTableLetters' \
+| gofmt -r 'pointerOverlapAffineType -> pointerOverlapAffineLetters' \
+>> overlap_affine_letters.go
+
+echo -e $WARNING\
+> overlap_affine_qletters.go
+cat < overlap_affine_type.got \
+| gofmt -r 'alignType -> alignQLetters' \
+| gofmt -r 'Type -> alphabet.QLetters' \
+| gofmt -r 'drawOverlapAffineTableType -> drawOverlapAffineTableQLetters' \
+| gofmt -r 'pointerOverlapAffineType -> pointerOverlapAffineQLetters' \
+| gofmt -r 'rSeq[i] -> rSeq[i].L' \
+| gofmt -r 'qSeq[i] -> qSeq[i].L' \
+>> overlap_affine_qletters.go
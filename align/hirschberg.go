@@ -0,0 +1,348 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// hirschbergCells is the maximum number of cells in a dynamic programming table
+// that the linear space aligners will fill completely. Problems larger than this
+// are divided until they fit.
+var hirschbergCells = 1 << 16
+
+// Hirschberg is the linear gap penalty Needleman-Wunsch aligner type using Hirschberg's
+// divide and conquer algorithm. It requires O(n+m) space rather than the O(n×m) needed by NW.
+// Alignments of sequences small enough to be aligned within a small fixed size table are
+// identical to those returned by NW. For larger sequences, the returned alignment has the
+// same score as the alignment returned by NW, but where there are multiple optimal
+// alignments a different one may be returned.
+type Hirschberg Linear
+
+// Align aligns two sequences using Hirschberg's algorithm. It returns an alignment description
+// or an error if the scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a Hirschberg) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if (reference.Slice().Len()+1)*(query.Slice().Len()+1) <= hirschbergCells {
+		return NW(a).Align(reference, query)
+	}
+	la, let, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return nil, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hirschberg{
+		la: la, let: let,
+		rSeq: rSeq, qSeq: qSeq,
+		f: make([]int, len(qSeq)+1),
+		b: make([]int, len(qSeq)+1),
+
+		moves: make([]byte, 0, len(rSeq)+len(qSeq)),
+	}
+	h.align(0, len(rSeq), 0, len(qSeq))
+
+	return pathPairs(h.moves, rSeq, qSeq, la, let, 0), nil
+}
+
+// hirschberg holds the state for a linear gap penalty Hirschberg alignment.
+type hirschberg struct {
+	la  []int
+	let int
+
+	rSeq, qSeq []int
+
+	// f and b hold the forward and backward
+	// score rows used to find the split.
+	f, b []int
+
+	table []int
+
+	// moves is the path of the alignment
+	// in diag, up and left moves.
+	moves []byte
+}
+
+// align appends the moves of an optimal path from (i0, j0) to (i1, j1) to h.moves.
+func (h *hirschberg) align(i0, i1, j0, j1 int) {
+	if i1-i0 < 2 || (i1-i0+1)*(j1-j0+1) <= hirschbergCells {
+		h.full(i0, i1, j0, j1)
+		return
+	}
+
+	mid := (i0 + i1) / 2
+	h.forward(i0, mid, j0, j1)
+	h.backward(mid, i1, j0, j1)
+	best, split := minInt, j1
+	for j := j1; j >= j0; j-- {
+		if s := h.f[j] + h.b[j]; s > best {
+			best, split = s, j
+		}
+	}
+
+	h.align(i0, mid, j0, split)
+	h.align(mid, i1, split, j1)
+}
+
+// forward fills h.f[j0:j1+1] with the scores of the best paths from (i0, j0) to
+// each node in row i1.
+func (h *hirschberg) forward(i0, i1, j0, j1 int) {
+	la, let := h.la, h.let
+	f := h.f
+	f[j0] = 0
+	for j := j0 + 1; j <= j1; j++ {
+		f[j] = f[j-1] + la[h.qSeq[j-1]]
+	}
+	for i := i0 + 1; i <= i1; i++ {
+		rVal := h.rSeq[i-1]
+		diagScore := f[j0]
+		f[j0] += la[rVal*let]
+		for j := j0 + 1; j <= j1; j++ {
+			qVal := h.qSeq[j-1]
+			score := max3(
+				diagScore+la[rVal*let+qVal],
+				f[j]+la[rVal*let],
+				f[j-1]+la[qVal],
+			)
+			diagScore, f[j] = f[j], score
+		}
+	}
+}
+
+// backward fills h.b[j0:j1+1] with the scores of the best paths from each node
+// in row i0 to (i1, j1).
+func (h *hirschberg) backward(i0, i1, j0, j1 int) {
+	la, let := h.la, h.let
+	b := h.b
+	b[j1] = 0
+	for j := j1 - 1; j >= j0; j-- {
+		b[j] = b[j+1] + la[h.qSeq[j]]
+	}
+	for i := i1 - 1; i >= i0; i-- {
+		rVal := h.rSeq[i]
+		diagScore := b[j1]
+		b[j1] += la[rVal*let]
+		for j := j1 - 1; j >= j0; j-- {
+			qVal := h.qSeq[j]
+			score := max3(
+				diagScore+la[rVal*let+qVal],
+				b[j]+la[rVal*let],
+				b[j+1]+la[qVal],
+			)
+			diagScore, b[j] = b[j], score
+		}
+	}
+}
+
+// full appends the moves of an optimal path from (i0, j0) to (i1, j1) to h.moves
+// using a complete dynamic programming table. Ties are resolved in the same way
+// as NW.
+func (h *hirschberg) full(i0, i1, j0, j1 int) {
+	la, let := h.la, h.let
+	r, c := i1-i0+1, j1-j0+1
+	if cap(h.table) < r*c {
+		h.table = make([]int, r*c)
+	}
+	table := h.table[:r*c]
+	rSeq, qSeq := h.rSeq[i0:i1], h.qSeq[j0:j1]
+
+	table[0] = 0
+	for j := range table[1:c] {
+		table[j+1] = table[j] + la[qSeq[j]]
+	}
+	for i := 1; i < r; i++ {
+		table[i*c] = table[(i-1)*c] + la[rSeq[i-1]*let]
+	}
+	for i := 1; i < r; i++ {
+		for j := 1; j < c; j++ {
+			var (
+				rVal = rSeq[i-1]
+				qVal = qSeq[j-1]
+			)
+			p := i*c + j
+
+			diagScore := table[p-c-1] + la[rVal*let+qVal]
+			upScore := table[p-c] + la[rVal*let]
+			leftScore := table[p-1] + la[qVal]
+
+			table[p] = max3(diagScore, upScore, leftScore)
+		}
+	}
+
+	start := len(h.moves)
+	i, j := r-1, c-1
+	for i > 0 && j > 0 {
+		var (
+			rVal = rSeq[i-1]
+			qVal = qSeq[j-1]
+		)
+		switch p := i*c + j; table[p] {
+		case table[p-c-1] + la[rVal*let+qVal]:
+			h.moves = append(h.moves, diag)
+			i--
+			j--
+		case table[p-c] + la[rVal*let]:
+			h.moves = append(h.moves, up)
+			i--
+		case table[p-1] + la[qVal]:
+			h.moves = append(h.moves, left)
+			j--
+		default:
+			panic(fmt.Sprintf("align: hirschberg internal error: no path at row: %d col:%d\n", i0+i, j0+j))
+		}
+	}
+	for ; i > 0; i-- {
+		h.moves = append(h.moves, up)
+	}
+	for ; j > 0; j-- {
+		h.moves = append(h.moves, left)
+	}
+	reverseMoves(h.moves[start:])
+}
+
+func reverseMoves(m []byte) {
+	for i, j := 0, len(m)-1; i < j; i, j = i+1, j-1 {
+		m[i], m[j] = m[j], m[i]
+	}
+}
+
+// pathPairs returns the feature pairs described by the alignment path in moves
+// starting from the beginning of both sequences. Each gap is penalised by open
+// in addition to the gap penalties in the flattened scoring matrix la.
+func pathPairs(moves []byte, rSeq, qSeq []int, la []int, let, open int) []feat.Pair {
+	var (
+		aln []feat.Pair
+
+		i, j   int
+		score  int
+		last   = byte(diag)
+		si, sj int
+	)
+	for n, m := range moves {
+		if n != 0 && m != last {
+			aln = append(aln, &featPair{
+				a:     feature{start: si, end: i},
+				b:     feature{start: sj, end: j},
+				score: score,
+			})
+			si, sj = i, j
+			score = 0
+		}
+		switch m {
+		case diag:
+			score += la[rSeq[i]*let+qSeq[j]]
+			i++
+			j++
+		case up:
+			if last != up {
+				score += open
+			}
+			score += la[rSeq[i]*let]
+			i++
+		case left:
+			if last != left {
+				score += open
+			}
+			score += la[qSeq[j]]
+			j++
+		}
+		last = m
+	}
+	if len(moves) != 0 {
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+	return aln
+}
+
+// flatten returns the scoring matrix m as a flat slice and its stride after checking
+// that it is square and large enough for alpha.
+func flatten(m Linear, alpha alphabet.Alphabet) (la []int, let int, err error) {
+	let = len(m)
+	if let < alpha.Len() {
+		return nil, 0, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la = make([]int, 0, let*let)
+	for _, row := range m {
+		if len(row) != let {
+			return nil, 0, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+	return la, let, nil
+}
+
+// indexPair returns the alphabet indices of the letters in reference and query.
+func indexPair(reference, query alphabet.Slice, alpha alphabet.Alphabet) (rIdx, qIdx []int, err error) {
+	index := alpha.LetterIndex()
+	switch rSeq := reference.(type) {
+	case alphabet.Letters:
+		qSeq, ok := query.(alphabet.Letters)
+		if !ok {
+			return nil, nil, ErrMismatchedTypes
+		}
+		rIdx, err = indicesOfLetters(rSeq, index, "rSeq")
+		if err != nil {
+			return nil, nil, err
+		}
+		qIdx, err = indicesOfLetters(qSeq, index, "qSeq")
+	case alphabet.QLetters:
+		qSeq, ok := query.(alphabet.QLetters)
+		if !ok {
+			return nil, nil, ErrMismatchedTypes
+		}
+		rIdx, err = indicesOfQLetters(rSeq, index, "rSeq")
+		if err != nil {
+			return nil, nil, err
+		}
+		qIdx, err = indicesOfQLetters(qSeq, index, "qSeq")
+	default:
+		return nil, nil, ErrTypeNotHandled
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return rIdx, qIdx, nil
+}
+
+func indicesOfLetters(s alphabet.Letters, index alphabet.Index, name string) ([]int, error) {
+	idx := make([]int, len(s))
+	for i, l := range s {
+		idx[i] = index[l]
+		if idx[i] < 0 {
+			return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", l, i, name)
+		}
+	}
+	return idx, nil
+}
+
+func indicesOfQLetters(s alphabet.QLetters, index alphabet.Index, name string) ([]int, error) {
+	idx := make([]int, len(s))
+	for i, l := range s {
+		idx[i] = index[l.L]
+		if idx[i] < 0 {
+			return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", l.L, i, name)
+		}
+	}
+	return idx, nil
+}
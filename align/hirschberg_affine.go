@@ -0,0 +1,286 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// HirschbergAffine is the affine gap penalty Needleman-Wunsch aligner type using the
+// Myers and Miller extension of Hirschberg's divide and conquer algorithm. It requires
+// O(n+m) space rather than the O(n×m) needed by NWAffine. Alignments of sequences small
+// enough to be aligned within a small fixed size table are identical to those returned by
+// NWAffine. For larger sequences, the returned alignment has the same score as the alignment
+// returned by NWAffine, but where there are multiple optimal alignments a different one may
+// be returned.
+type HirschbergAffine Affine
+
+// Align aligns two sequences using the Myers and Miller algorithm. It returns an alignment description
+// or an error if the scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a HirschbergAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	if (reference.Slice().Len()+1)*(query.Slice().Len()+1) <= hirschbergCells {
+		return NWAffine(a).Align(reference, query)
+	}
+	la, let, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	c := len(qSeq) + 1
+	h := myersMiller{
+		la: la, let: let, open: a.GapOpen,
+		rSeq: rSeq, qSeq: qSeq,
+		f: make([][3]int, c),
+		b: make([][3]int, c),
+
+		moves: make([]byte, 0, len(rSeq)+len(qSeq)),
+	}
+	h.align(0, len(rSeq), 0, len(qSeq), diag, free)
+
+	return pathPairs(h.moves, rSeq, qSeq, la, let, a.GapOpen), nil
+}
+
+// free indicates that a myersMiller sub-alignment may end in any layer.
+const free = -1
+
+// myersMiller holds the state for an affine gap penalty Hirschberg alignment.
+// The layers of the dynamic programming are indexed by the move that entered
+// a node: diag, up or left. As in NWAffine, an up move may not directly follow
+// a left move and a left move may not directly follow an up move.
+type myersMiller struct {
+	la   []int
+	let  int
+	open int
+
+	rSeq, qSeq []int
+
+	// f and b hold the forward and backward
+	// score rows used to find the split.
+	f, b [][3]int
+
+	table [][3]int
+
+	// moves is the path of the alignment
+	// in diag, up and left moves.
+	moves []byte
+}
+
+// align appends the moves of an optimal path from (i0, j0) entered by a move of
+// layer start to (i1, j1) entered by a move of layer end to h.moves. If end is
+// free, the path may end in any layer.
+func (h *myersMiller) align(i0, i1, j0, j1, start, end int) {
+	if i1-i0 < 2 || (i1-i0+1)*(j1-j0+1) <= hirschbergCells {
+		h.full(i0, i1, j0, j1, start, end)
+		return
+	}
+
+	mid := (i0 + i1) / 2
+	h.forward(i0, mid, j0, j1, start)
+	h.backward(mid, i1, j0, j1, end)
+	best, split, layer := minInt, j1, diag
+	for j := j1; j >= j0; j-- {
+		for l := diag; l <= left; l++ {
+			if s := add(h.f[j][l], h.b[j][l]); s > best {
+				best, split, layer = s, j, l
+			}
+		}
+	}
+
+	h.align(i0, mid, j0, split, start, layer)
+	h.align(mid, i1, split, j1, layer, end)
+}
+
+// forward fills h.f[j0:j1+1] with the scores of the best paths from (i0, j0)
+// entered by a move of layer start to each node in row i1 for each layer.
+func (h *myersMiller) forward(i0, i1, j0, j1, start int) {
+	la, let, open := h.la, h.let, h.open
+	f := h.f
+	f[j0] = [3]int{minInt, minInt, minInt}
+	f[j0][start] = 0
+	for j := j0 + 1; j <= j1; j++ {
+		f[j] = [3]int{
+			diag: minInt,
+			up:   minInt,
+			left: add(max2(add(f[j-1][diag], open), f[j-1][left]), la[h.qSeq[j-1]]),
+		}
+	}
+	for i := i0 + 1; i <= i1; i++ {
+		rVal := h.rSeq[i-1]
+		diagScores := f[j0]
+		f[j0] = [3]int{
+			diag: minInt,
+			up:   add(max2(add(f[j0][diag], open), f[j0][up]), la[rVal*let]),
+			left: minInt,
+		}
+		for j := j0 + 1; j <= j1; j++ {
+			qVal := h.qSeq[j-1]
+			score := [3]int{
+				diag: add(max3(diagScores[diag], diagScores[up], diagScores[left]), la[rVal*let+qVal]),
+				up:   add(max2(add(f[j][diag], open), f[j][up]), la[rVal*let]),
+				left: add(max2(add(f[j-1][diag], open), f[j-1][left]), la[qVal]),
+			}
+			diagScores, f[j] = f[j], score
+		}
+	}
+}
+
+// backward fills h.b[j0:j1+1] with the scores of the best paths from each node
+// in row i0 for each entering layer to (i1, j1) entered by a move of layer end.
+func (h *myersMiller) backward(i0, i1, j0, j1, end int) {
+	la, let, open := h.la, h.let, h.open
+	b := h.b
+	b[j1] = [3]int{0, 0, 0}
+	if end != free {
+		b[j1] = [3]int{minInt, minInt, minInt}
+		b[j1][end] = 0
+	}
+	for j := j1 - 1; j >= j0; j-- {
+		qGap := la[h.qSeq[j]]
+		b[j] = [3]int{
+			diag: add(b[j+1][left], open+qGap),
+			up:   minInt,
+			left: add(b[j+1][left], qGap),
+		}
+	}
+	for i := i1 - 1; i >= i0; i-- {
+		rVal := h.rSeq[i]
+		rGap := la[rVal*let]
+		diagScores := b[j1]
+		b[j1] = [3]int{
+			diag: add(b[j1][up], open+rGap),
+			up:   add(b[j1][up], rGap),
+			left: minInt,
+		}
+		for j := j1 - 1; j >= j0; j-- {
+			qGap := la[h.qSeq[j]]
+			match := add(diagScores[diag], la[rVal*let+h.qSeq[j]])
+			score := [3]int{
+				diag: max3(match, add(b[j][up], open+rGap), add(b[j+1][left], open+qGap)),
+				up:   max2(match, add(b[j][up], rGap)),
+				left: max2(match, add(b[j+1][left], qGap)),
+			}
+			diagScores, b[j] = b[j], score
+		}
+	}
+}
+
+// full appends the moves of an optimal path from (i0, j0) entered by a move of
+// layer start to (i1, j1) entered by a move of layer end to h.moves using a
+// complete dynamic programming table.
+func (h *myersMiller) full(i0, i1, j0, j1, start, end int) {
+	la, let, open := h.la, h.let, h.open
+	r, c := i1-i0+1, j1-j0+1
+	if cap(h.table) < r*c {
+		h.table = make([][3]int, r*c)
+	}
+	table := h.table[:r*c]
+	rSeq, qSeq := h.rSeq[i0:i1], h.qSeq[j0:j1]
+
+	table[0] = [3]int{minInt, minInt, minInt}
+	table[0][start] = 0
+	for j := 1; j < c; j++ {
+		table[j] = [3]int{
+			diag: minInt,
+			up:   minInt,
+			left: add(max2(add(table[j-1][diag], open), table[j-1][left]), la[qSeq[j-1]]),
+		}
+	}
+	for i := 1; i < r; i++ {
+		p := i * c
+		table[p] = [3]int{
+			diag: minInt,
+			up:   add(max2(add(table[p-c][diag], open), table[p-c][up]), la[rSeq[i-1]*let]),
+			left: minInt,
+		}
+	}
+	for i := 1; i < r; i++ {
+		for j := 1; j < c; j++ {
+			var (
+				rVal = rSeq[i-1]
+				qVal = qSeq[j-1]
+			)
+			p := i*c + j
+
+			table[p] = [3]int{
+				diag: add(max3(table[p-c-1][diag], table[p-c-1][up], table[p-c-1][left]), la[rVal*let+qVal]),
+				up:   add(max2(add(table[p-c][diag], open), table[p-c][up]), la[rVal*let]),
+				left: add(max2(add(table[p-1][diag], open), table[p-1][left]), la[qVal]),
+			}
+		}
+	}
+
+	i, j := r-1, c-1
+	layer := end
+	if layer == free {
+		t := table[i*c+j]
+		layer = diag
+		for l, s := range t[1:] {
+			if s > t[layer] {
+				layer = l + 1
+			}
+		}
+	}
+	moves := len(h.moves)
+	for i > 0 || j > 0 {
+		p := i*c + j
+		score := table[p][layer]
+		h.moves = append(h.moves, byte(layer))
+		switch layer {
+		case diag:
+			s := la[rSeq[i-1]*let+qSeq[j-1]]
+			switch score {
+			case add(table[p-c-1][up], s):
+				layer = up
+			case add(table[p-c-1][left], s):
+				layer = left
+			case add(table[p-c-1][diag], s):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: myers miller internal error: no path at row: %d col:%d layer:%s\n", i0+i, j0+j, "mul"[layer:layer+1]))
+			}
+			i--
+			j--
+		case up:
+			g := la[rSeq[i-1]*let]
+			switch score {
+			case add(table[p-c][up], g):
+				layer = up
+			case add(table[p-c][diag], open+g):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: myers miller internal error: no path at row: %d col:%d layer:%s\n", i0+i, j0+j, "mul"[layer:layer+1]))
+			}
+			i--
+		case left:
+			g := la[qSeq[j-1]]
+			switch score {
+			case add(table[p-1][left], g):
+				layer = left
+			case add(table[p-1][diag], open+g):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: myers miller internal error: no path at row: %d col:%d layer:%s\n", i0+i, j0+j, "mul"[layer:layer+1]))
+			}
+			j--
+		}
+	}
+	reverseMoves(h.moves[moves:])
+}
@@ -0,0 +1,82 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"errors"
+	"fmt"
+)
+
+// Codon substitution matrices are organised to allow direct lookup using the letter indices
+// of alphabet.Codon and so can be used as an align.Linear with codon encoded sequences
+// produced by alphabet.EncodeCodons. Scores for the gap letter and for the ambiguous codon
+// letter are zero. Empirical codon matrices can be derived from aligned coding sequences
+// using a PairCounts created with alphabet.Codon.
+
+// SynonymousCodon returns a codon substitution matrix for the genetic code gc that scores
+// identical codons with match, pairs of different codons that translate to the same amino
+// acid, or are both stop codons, with synonymous, and all other pairs of codons with
+// nonsynonymous.
+func SynonymousCodon(gc *alphabet.GeneticCode, match, synonymous, nonsynonymous int) [][]int {
+	return codonMatrix(func(a, b alphabet.Letter) int {
+		switch {
+		case a == b:
+			return match
+		case gc.Translate(a) == gc.Translate(b):
+			return synonymous
+		default:
+			return nonsynonymous
+		}
+	})
+}
+
+// CodonFromProtein returns a codon substitution matrix for the genetic code gc that scores
+// each pair of codons with the score in the protein substitution matrix m of the amino acids
+// they translate to. The protein matrix must be organised for direct lookup using the letter
+// indices of alphabet.Protein, as the matrices in this package are. Identical codons score
+// an additional identity bonus, so that synonymous substitutions score less than identity.
+func CodonFromProtein(gc *alphabet.GeneticCode, m [][]int, identity int) ([][]int, error) {
+	if len(m) != alphabet.Protein.Len() {
+		return nil, fmt.Errorf("matrix: matrix size %d does not match protein alphabet length %d", len(m), alphabet.Protein.Len())
+	}
+	for _, row := range m {
+		if len(row) != len(m) {
+			return nil, errors.New("matrix: protein matrix is not square")
+		}
+	}
+	index := alphabet.Protein.LetterIndex()
+	return codonMatrix(func(a, b alphabet.Letter) int {
+		s := m[index[gc.Translate(a)]][index[gc.Translate(b)]]
+		if a == b {
+			s += identity
+		}
+		return s
+	}), nil
+}
+
+// codonMatrix returns a codon substitution matrix with scores for each pair of codon letters
+// given by score.
+func codonMatrix(score func(a, b alphabet.Letter) int) [][]int {
+	alpha := alphabet.Codon
+	m := make([][]int, alpha.Len())
+	for i := range m {
+		m[i] = make([]int, alpha.Len())
+	}
+	gap, ambig := alpha.IndexOf(alpha.Gap()), alpha.IndexOf(alpha.Ambiguous())
+	for i := range m {
+		if i == gap || i == ambig {
+			continue
+		}
+		for j := range m[i] {
+			if j == gap || j == ambig {
+				continue
+			}
+			m[i][j] = score(alpha.Letter(i), alpha.Letter(j))
+		}
+	}
+	return m
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix_test
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleSynonymousCodon() {
+	m := matrix.SynonymousCodon(alphabet.Standard, 5, 2, -4)
+	for i := range m {
+		m[i][0], m[0][i] = -6, -6
+	}
+	m[0][0] = 0
+
+	// The query has a synonymous substitution in the fourth codon
+	// and a deletion of the sixth codon.
+	ref := alphabet.EncodeCodons(alphabet.BytesToLetters([]byte("atgaaacgtctgtcttggggcaaa")))
+	query := alphabet.EncodeCodons(alphabet.BytesToLetters([]byte("atgaaacgtttatctggcaaa")))
+	rs := linear.NewSeq("ref", ref, alphabet.Codon)
+	qs := linear.NewSeq("query", query, alphabet.Codon)
+	aln, err := align.SW(m).Align(rs, qs)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa := align.Format(rs, qs, aln, '-')
+	for _, s := range fa {
+		nuc, err := alphabet.DecodeCodons(s.(alphabet.Letters))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(nuc)
+	}
+	// Output:
+	// [[0,5)/[0,5)=22 [5,6)/-=-6 [6,8)/[5,7)=10]
+	// atgaaacgtctgtcttggggcaaa
+	// atgaaacgtttatct---ggcaaa
+}
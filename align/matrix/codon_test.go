@@ -0,0 +1,58 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"gopkg.in/check.v1"
+)
+
+func codon(s string) int {
+	return alphabet.Codon.IndexOf(alphabet.CodonOf(alphabet.Letter(s[0]), alphabet.Letter(s[1]), alphabet.Letter(s[2])))
+}
+
+func (s *S) TestSynonymousCodon(c *check.C) {
+	m := SynonymousCodon(alphabet.Standard, 5, 2, -3)
+	c.Assert(len(m), check.Equals, alphabet.Codon.Len())
+	for _, t := range []struct {
+		a, b  string
+		score int
+	}{
+		{"ctg", "ctg", 5},
+		{"ctg", "tta", 2},  // Leucine.
+		{"taa", "tga", 2},  // Stop.
+		{"atg", "ata", -3}, // Methionine and isoleucine.
+		{"tgg", "tga", -3}, // Tryptophan and stop.
+		{"---", "atg", 0},
+		{"ann", "atg", 0},
+	} {
+		c.Check(m[codon(t.a)][codon(t.b)], check.Equals, t.score, check.Commentf("%s/%s", t.a, t.b))
+		c.Check(m[codon(t.b)][codon(t.a)], check.Equals, t.score, check.Commentf("%s/%s", t.b, t.a))
+	}
+}
+
+func (s *S) TestCodonFromProtein(c *check.C) {
+	m, err := CodonFromProtein(alphabet.Standard, BLOSUM62, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(m), check.Equals, alphabet.Codon.Len())
+	p := alphabet.Protein.LetterIndex()
+	for _, t := range []struct {
+		a, b  string
+		score int
+	}{
+		{"ctg", "ctg", BLOSUM62[p['l']][p['l']] + 2},
+		{"ctg", "tta", BLOSUM62[p['l']][p['l']]},
+		{"atg", "ata", BLOSUM62[p['m']][p['i']]},
+		{"tgg", "tga", BLOSUM62[p['w']][p['*']]},
+		{"---", "atg", 0},
+		{"ann", "atg", 0},
+	} {
+		c.Check(m[codon(t.a)][codon(t.b)], check.Equals, t.score, check.Commentf("%s/%s", t.a, t.b))
+	}
+
+	_, err = CodonFromProtein(alphabet.Standard, NUC_4, 2)
+	c.Check(err, check.ErrorMatches, "matrix: matrix size 5 does not match protein alphabet length 26")
+}
@@ -0,0 +1,315 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MatchMismatch returns a log-odds scoring matrix for alpha derived from a model where
+// aligned letters are identical with probability identity and letters occur with the
+// background frequencies in freqs. Under the model, a match of letter a scores
+// log2(identity/p(a)) and all mismatches score log2((1-identity)/(1-Σp²)), so matches
+// of rare letters score more highly than matches of common letters. If freqs is nil
+// all letters of alpha other than the gap are equally frequent.
+//
+// Scores are expressed in units of 1/scale bits and rounded to the nearest integer.
+// Scores for the gap letter and for letters without a background frequency are zero.
+func MatchMismatch(alpha alphabet.Alphabet, identity float64, freqs map[alphabet.Letter]float64, scale float64) ([][]int, error) {
+	if identity <= 0 || identity >= 1 {
+		return nil, fmt.Errorf("matrix: identity %v out of range (0, 1)", identity)
+	}
+	p, err := background(alpha, freqs)
+	if err != nil {
+		return nil, err
+	}
+	var sumSq float64
+	for _, v := range p {
+		sumSq += v * v
+	}
+	if sumSq == 1 {
+		return nil, errors.New("matrix: mismatches impossible with a single letter")
+	}
+
+	q := make([][]float64, len(p))
+	for i := range q {
+		q[i] = make([]float64, len(p))
+		for j := range q[i] {
+			if i == j {
+				q[i][j] = identity * p[i]
+			} else {
+				q[i][j] = (1 - identity) * p[i] * p[j] / (1 - sumSq)
+			}
+		}
+	}
+	return logOdds(q, p, scale)
+}
+
+// Nucleotide returns a log-odds scoring matrix for the nucleic acid alphabet alpha derived
+// from a model where aligned bases are identical with probability identity, mismatched
+// pairs are transitions and transversions in the ratio tstv, and bases occur with the
+// background frequencies in freqs. If freqs is nil the bases a, c, g and t, or u if alpha
+// does not include t, are equally frequent. Frequencies may only be given for the four
+// bases.
+//
+// Scores are expressed in units of 1/scale bits and rounded to the nearest integer.
+// Scores for the gap letter and for ambiguous bases are zero.
+func Nucleotide(alpha alphabet.Alphabet, identity, tstv float64, freqs map[alphabet.Letter]float64, scale float64) ([][]int, error) {
+	if m := alpha.Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("matrix: alphabet is not nucleic acid")
+	}
+	if identity <= 0 || identity >= 1 {
+		return nil, fmt.Errorf("matrix: identity %v out of range (0, 1)", identity)
+	}
+	if tstv <= 0 {
+		return nil, fmt.Errorf("matrix: transition/transversion ratio %v is not positive", tstv)
+	}
+
+	t := alphabet.Letter('t')
+	if alpha.IndexOf(t) < 0 {
+		t = 'u'
+	}
+	bases := []alphabet.Letter{'a', 'c', 'g', t}
+	for _, b := range bases {
+		if alpha.IndexOf(b) < 0 {
+			return nil, fmt.Errorf("matrix: alphabet has no %q", b)
+		}
+	}
+	purine := map[alphabet.Letter]bool{'a': true, 'g': true}
+	if freqs == nil {
+		freqs = map[alphabet.Letter]float64{'a': 1, 'c': 1, 'g': 1, t: 1}
+	}
+	for l := range freqs {
+		switch l | ('a' - 'A') {
+		case 'a', 'c', 'g', t:
+		default:
+			return nil, fmt.Errorf("matrix: frequency given for non-base letter %q", l)
+		}
+	}
+	p, err := background(alpha, freqs)
+	if err != nil {
+		return nil, err
+	}
+
+	var ts, tv float64
+	for _, a := range bases {
+		for _, b := range bases {
+			if a == b {
+				continue
+			}
+			w := p[alpha.IndexOf(a)] * p[alpha.IndexOf(b)]
+			if purine[a] == purine[b] {
+				ts += w
+			} else {
+				tv += w
+			}
+		}
+	}
+	if ts == 0 || tv == 0 {
+		return nil, errors.New("matrix: base frequencies do not allow both transitions and transversions")
+	}
+	tsShare := (1 - identity) * tstv / (1 + tstv)
+	tvShare := (1 - identity) / (1 + tstv)
+
+	q := make([][]float64, len(p))
+	for i := range q {
+		q[i] = make([]float64, len(p))
+	}
+	for _, a := range bases {
+		i := alpha.IndexOf(a)
+		for _, b := range bases {
+			j := alpha.IndexOf(b)
+			switch {
+			case a == b:
+				q[i][j] = identity * p[i]
+			case purine[a] == purine[b]:
+				q[i][j] = tsShare * p[i] * p[j] / ts
+			default:
+				q[i][j] = tvShare * p[i] * p[j] / tv
+			}
+		}
+	}
+	return logOdds(q, p, scale)
+}
+
+// PairCounts holds counts of aligned letter pairs for deriving a log-odds scoring matrix.
+type PairCounts struct {
+	alpha alphabet.Alphabet
+	n     [][]float64
+}
+
+// NewPairCounts returns a new PairCounts for letters of alpha.
+func NewPairCounts(alpha alphabet.Alphabet) *PairCounts {
+	n := make([][]float64, alpha.Len())
+	for i := range n {
+		n[i] = make([]float64, alpha.Len())
+	}
+	return &PairCounts{alpha: alpha, n: n}
+}
+
+// Add adds a single observation of letter a aligned with letter b. Add returns an error
+// if either letter is not valid in the alphabet or is the gap letter.
+func (c *PairCounts) Add(a, b alphabet.Letter) error {
+	i, j := c.alpha.IndexOf(a), c.alpha.IndexOf(b)
+	if i < 0 || j < 0 {
+		return fmt.Errorf("matrix: invalid letter pair %q/%q", a, b)
+	}
+	if a == c.alpha.Gap() || b == c.alpha.Gap() {
+		return fmt.Errorf("matrix: gap in letter pair %q/%q", a, b)
+	}
+	c.n[i][j]++
+	return nil
+}
+
+// AddAligned adds observations of all pairs of letters in each column of the multiple
+// alignment a, such as a *multi.Multi. Gaps and letters that are not valid in the alphabet
+// are ignored.
+func (c *PairCounts) AddAligned(a seq.Aligned) {
+	gap := c.alpha.Gap()
+	idx := make([]int, 0, a.Rows())
+	for pos := a.Start(); pos < a.End(); pos++ {
+		idx = idx[:0]
+		for _, l := range a.Column(pos, false) {
+			if l == gap {
+				continue
+			}
+			if i := c.alpha.IndexOf(l); i >= 0 {
+				idx = append(idx, i)
+			}
+		}
+		for k, i := range idx {
+			for _, j := range idx[k+1:] {
+				c.n[i][j]++
+			}
+		}
+	}
+}
+
+// LogOdds returns a log-odds scoring matrix for the alphabet derived from the observed pair
+// counts. Pair counts are symmetrised and each pair of letters that has been observed is
+// given an additional pseudo count. Background letter frequencies are the marginal
+// frequencies of the symmetrised pair frequencies.
+//
+// Scores are expressed in units of 1/scale bits and rounded to the nearest integer. Pairs
+// of observed letters with a zero frequency are given the lowest finite score, and scores
+// for the gap letter and for unobserved letters are zero.
+func (c *PairCounts) LogOdds(scale, pseudo float64) ([][]int, error) {
+	if pseudo < 0 {
+		return nil, fmt.Errorf("matrix: negative pseudo count %v", pseudo)
+	}
+	observed := make([]bool, len(c.n))
+	for i := range c.n {
+		for j, v := range c.n[i] {
+			if v != 0 {
+				observed[i] = true
+				observed[j] = true
+			}
+		}
+	}
+
+	q := make([][]float64, len(c.n))
+	var total float64
+	for i := range q {
+		q[i] = make([]float64, len(c.n))
+		if !observed[i] {
+			continue
+		}
+		for j := range q[i] {
+			if !observed[j] {
+				continue
+			}
+			q[i][j] = (c.n[i][j]+c.n[j][i])/2 + pseudo
+			total += q[i][j]
+		}
+	}
+	if total == 0 {
+		return nil, errors.New("matrix: no observed pairs")
+	}
+	p := make([]float64, len(q))
+	for i := range q {
+		for j := range q[i] {
+			q[i][j] /= total
+			p[i] += q[i][j]
+		}
+	}
+	return logOdds(q, p, scale)
+}
+
+// background returns the background frequencies for alpha given in freqs indexed by
+// letter index and normalised to sum to one. If freqs is nil all letters of alpha other
+// than the gap are equally frequent.
+func background(alpha alphabet.Alphabet, freqs map[alphabet.Letter]float64) ([]float64, error) {
+	p := make([]float64, alpha.Len())
+	g := alpha.IndexOf(alpha.Gap())
+	if freqs == nil {
+		for i := range p {
+			if i != g {
+				p[i] = 1
+			}
+		}
+	} else {
+		for l, v := range freqs {
+			i := alpha.IndexOf(l)
+			if i < 0 || i == g {
+				return nil, fmt.Errorf("matrix: invalid letter %q in background frequencies", l)
+			}
+			if v < 0 {
+				return nil, fmt.Errorf("matrix: negative background frequency for %q", l)
+			}
+			p[i] += v
+		}
+	}
+	var sum float64
+	for _, v := range p {
+		sum += v
+	}
+	if sum == 0 {
+		return nil, errors.New("matrix: no background frequencies")
+	}
+	for i := range p {
+		p[i] /= sum
+	}
+	return p, nil
+}
+
+// logOdds returns the log-odds scores in units of 1/scale bits for the target pair
+// frequencies q and background frequencies p.
+func logOdds(q [][]float64, p []float64, scale float64) ([][]int, error) {
+	if scale <= 0 {
+		return nil, fmt.Errorf("matrix: scale %v is not positive", scale)
+	}
+	s := make([][]float64, len(q))
+	low := math.Inf(1)
+	for i := range q {
+		s[i] = make([]float64, len(q))
+		for j := range q[i] {
+			if p[i] == 0 || p[j] == 0 {
+				continue
+			}
+			s[i][j] = scale * math.Log2(q[i][j]/(p[i]*p[j]))
+			if !math.IsInf(s[i][j], -1) {
+				low = math.Min(low, s[i][j])
+			}
+		}
+	}
+	m := make([][]int, len(q))
+	for i := range m {
+		m[i] = make([]int, len(q))
+		for j := range m[i] {
+			v := s[i][j]
+			if math.IsInf(v, -1) {
+				v = low
+			}
+			m[i][j] = int(math.Round(v))
+		}
+	}
+	return m, nil
+}
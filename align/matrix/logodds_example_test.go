@@ -0,0 +1,35 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix_test
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+
+	"fmt"
+	"os"
+)
+
+func ExampleNucleotide() {
+	// A matrix for alignments at 80% identity in a genome
+	// with 70% A+T and twice as many transitions as
+	// transversions in 1/2 bit units.
+	atRich := map[alphabet.Letter]float64{'a': 0.35, 'c': 0.15, 'g': 0.15, 't': 0.35}
+	m, err := matrix.Nucleotide(alphabet.DNAgapped, 0.8, 2, atRich, 2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	err = matrix.Write(os.Stdout, m, alphabet.DNAgapped)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	//    A  C  G  T
+	// A  2 -6 -1 -6
+	// C -6  5 -6 -1
+	// G -1 -6  5 -6
+	// T -6 -1 -6  2
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"reflect"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestMatchMismatch(c *check.C) {
+	m, err := MatchMismatch(alphabet.DNAgapped, 0.75, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.DeepEquals, Match(alphabet.DNAgapped, 0, 3, -3))
+
+	// Matches of rare bases score more highly.
+	atRich := map[alphabet.Letter]float64{'a': 0.35, 'c': 0.15, 'g': 0.15, 't': 0.35}
+	m, err = MatchMismatch(alphabet.DNAgapped, 0.75, atRich, 2)
+	c.Assert(err, check.Equals, nil)
+	a, g := alphabet.DNAgapped.IndexOf('a'), alphabet.DNAgapped.IndexOf('g')
+	c.Check(m[g][g] > m[a][a], check.Equals, true)
+	c.Check(m[a][g], check.Equals, m[g][a])
+
+	_, err = MatchMismatch(alphabet.DNAgapped, 1, nil, 2)
+	c.Check(err, check.ErrorMatches, `matrix: identity 1 out of range \(0, 1\)`)
+	_, err = MatchMismatch(alphabet.DNAgapped, 0.5, map[alphabet.Letter]float64{'-': 1}, 2)
+	c.Check(err, check.ErrorMatches, "matrix: invalid letter '-' in background frequencies")
+	_, err = MatchMismatch(alphabet.DNAgapped, 0.5, map[alphabet.Letter]float64{'a': 1}, 2)
+	c.Check(err, check.ErrorMatches, "matrix: mismatches impossible with a single letter")
+	_, err = MatchMismatch(alphabet.DNAgapped, 0.5, nil, 0)
+	c.Check(err, check.ErrorMatches, "matrix: scale 0 is not positive")
+}
+
+func (s *S) TestNucleotide(c *check.C) {
+	// With a transition/transversion ratio of 0.5 and uniform bases, all
+	// mismatches are equally likely, matching the match/mismatch model.
+	m, err := Nucleotide(alphabet.DNAgapped, 0.75, 0.5, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	want, err := MatchMismatch(alphabet.DNAgapped, 0.75, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.DeepEquals, want)
+
+	m, err = Nucleotide(alphabet.DNAredundant, 0.75, 2, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	idx := alphabet.DNAredundant.LetterIndex()
+	c.Check(m[idx['a']][idx['g']] > m[idx['a']][idx['c']], check.Equals, true)
+	c.Check(m[idx['c']][idx['t']] > m[idx['c']][idx['g']], check.Equals, true)
+	c.Check(m[idx['n']][idx['a']], check.Equals, 0)
+
+	m, err = Nucleotide(alphabet.RNAgapped, 0.75, 0.5, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.DeepEquals, want)
+
+	_, err = Nucleotide(alphabet.Protein, 0.75, 2, nil, 2)
+	c.Check(err, check.ErrorMatches, "matrix: alphabet is not nucleic acid")
+	_, err = Nucleotide(alphabet.DNAredundant, 0.75, 2, map[alphabet.Letter]float64{'n': 1}, 2)
+	c.Check(err, check.ErrorMatches, "matrix: frequency given for non-base letter 'n'")
+	_, err = Nucleotide(alphabet.DNAgapped, 0.75, 0, nil, 2)
+	c.Check(err, check.ErrorMatches, "matrix: transition/transversion ratio 0 is not positive")
+}
+
+func (s *S) TestPairCounts(c *check.C) {
+	// Counts in the proportions of the match/mismatch model
+	// give the same matrix.
+	pc := NewPairCounts(alphabet.DNAgapped)
+	for _, a := range "acgt" {
+		for _, b := range "acgt" {
+			n := 1
+			if a == b {
+				n = 9
+			}
+			for k := 0; k < n; k++ {
+				c.Assert(pc.Add(alphabet.Letter(a), alphabet.Letter(b)), check.Equals, nil)
+			}
+		}
+	}
+	m, err := pc.LogOdds(2, 0)
+	c.Assert(err, check.Equals, nil)
+	want, err := MatchMismatch(alphabet.DNAgapped, 0.75, nil, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(m, check.DeepEquals, want)
+
+	c.Check(pc.Add('a', '-'), check.ErrorMatches, "matrix: gap in letter pair 'a'/'-'")
+	c.Check(pc.Add('a', 'z'), check.ErrorMatches, "matrix: invalid letter pair 'a'/'z'")
+
+	ma, err := multi.NewMulti("aligned",
+		[]seq.Sequence{
+			linear.NewSeq("1", []alphabet.Letter("ACGCTGA-CTTGG"), alphabet.DNAgapped),
+			linear.NewSeq("2", []alphabet.Letter("ACGGTGACCTTGG"), alphabet.DNAgapped),
+			linear.NewSeq("3", []alphabet.Letter("ACGATGACGTTGG"), alphabet.DNAgapped),
+		},
+		seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	pc = NewPairCounts(alphabet.DNAgapped)
+	pc.AddAligned(ma)
+	idx := alphabet.DNAgapped.LetterIndex()
+	c.Check(pc.n[idx['g']][idx['g']], check.Equals, 4.0*3)
+	c.Check(pc.n[idx['c']][idx['c']], check.Equals, 3.0+1+1)
+	m, err = pc.LogOdds(2, 0.5)
+	c.Assert(err, check.Equals, nil)
+	c.Check(reflect.DeepEqual(m, transpose(m)), check.Equals, true)
+	c.Check(m[idx['g']][idx['g']] > 0, check.Equals, true)
+	c.Check(m[idx['a']][idx['c']] < 0, check.Equals, true)
+
+	_, err = NewPairCounts(alphabet.DNAgapped).LogOdds(2, 1)
+	c.Check(err, check.ErrorMatches, "matrix: no observed pairs")
+}
+
+func transpose(m [][]int) [][]int {
+	t := make([][]int, len(m))
+	for i := range t {
+		t[i] = make([]int, len(m))
+		for j := range t[i] {
+			t[i][j] = m[j][i]
+		}
+	}
+	return t
+}
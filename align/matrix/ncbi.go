@@ -0,0 +1,145 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Read reads an NCBI or EMBOSS format scoring matrix from r and returns a square matrix
+// organised to allow direct lookup using the letter indices of alpha, suitable for use as
+// an align.Linear. Lines starting with '#' are comments. The first remaining line lists the
+// column letters and each following line holds a row letter and the scores for each column.
+// Letters that are not valid in alpha are ignored. Scores for letters of alpha that are not
+// present in the file, including the gap letter, are zero.
+func Read(r io.Reader, alpha alphabet.Alphabet) ([][]int, error) {
+	index := alpha.LetterIndex()
+	m := make([][]int, alpha.Len())
+	for i := range m {
+		m[i] = make([]int, alpha.Len())
+	}
+
+	var (
+		cols []int
+		seen = make(map[byte]bool)
+		line int
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		f := strings.Fields(sc.Text())
+		if len(f) == 0 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		if cols == nil {
+			cols = make([]int, len(f))
+			for i, l := range f {
+				if len(l) != 1 {
+					return nil, fmt.Errorf("matrix: invalid column letter %q at line %d", l, line)
+				}
+				cols[i] = index[l[0]]
+			}
+			continue
+		}
+		if len(f[0]) != 1 {
+			return nil, fmt.Errorf("matrix: invalid row letter %q at line %d", f[0], line)
+		}
+		if len(f) != len(cols)+1 {
+			return nil, fmt.Errorf("matrix: row %q has %d scores, expected %d at line %d", f[0], len(f)-1, len(cols), line)
+		}
+		l := f[0][0]
+		if seen[l] {
+			return nil, fmt.Errorf("matrix: duplicate row %q at line %d", l, line)
+		}
+		seen[l] = true
+		row := index[l]
+		for j, s := range f[1:] {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("matrix: invalid score %q at line %d", s, line)
+			}
+			if row < 0 || cols[j] < 0 {
+				continue
+			}
+			m[row][cols[j]] = v
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cols == nil {
+		return nil, errors.New("matrix: no matrix data")
+	}
+	if len(seen) != len(cols) {
+		return nil, fmt.Errorf("matrix: %d rows for %d columns", len(seen), len(cols))
+	}
+
+	return m, nil
+}
+
+// Write writes the square matrix m, organised to allow direct lookup using the letter
+// indices of alpha, to w in NCBI format. The row and column for the gap letter of alpha
+// are not written and letters of alphabets that are not case sensitive are written in upper
+// case.
+func Write(w io.Writer, m [][]int, alpha alphabet.Alphabet) error {
+	if len(m) != alpha.Len() {
+		return fmt.Errorf("matrix: matrix size %d does not match alphabet length %d", len(m), alpha.Len())
+	}
+	for _, row := range m {
+		if len(row) != len(m) {
+			return errors.New("matrix: matrix is not square")
+		}
+	}
+
+	g := alpha.IndexOf(alpha.Gap())
+	var idx []int
+	for i := range m {
+		if i != g {
+			idx = append(idx, i)
+		}
+	}
+	width := 2
+	for _, i := range idx {
+		for _, j := range idx {
+			if n := len(strconv.Itoa(m[i][j])) + 1; n > width {
+				width = n
+			}
+		}
+	}
+
+	letter := alpha.Letter
+	if !alpha.IsCased() {
+		letter = func(i int) alphabet.Letter { return toUpper(alpha.Letter(i)) }
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(" ")
+	for _, i := range idx {
+		fmt.Fprintf(bw, "%*c", width, letter(i))
+	}
+	bw.WriteByte('\n')
+	for _, i := range idx {
+		fmt.Fprintf(bw, "%c", letter(i))
+		for _, j := range idx {
+			fmt.Fprintf(bw, "%*d", width, m[i][j])
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+func toUpper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l &^ ' '
+	}
+	return l
+}
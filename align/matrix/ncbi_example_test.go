@@ -0,0 +1,50 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix_test
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"strings"
+)
+
+func ExampleRead() {
+	const transitions = `
+# Transitions are penalised less than transversions.
+   A  C  G  T
+A  2 -3 -1 -3
+C -3  2 -3 -1
+G -1 -3  2 -3
+T -3 -1 -3  2
+`
+	m, err := matrix.Read(strings.NewReader(transitions), alphabet.DNAgapped)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for i := range m {
+		m[i][0], m[0][i] = -4, -4
+	}
+	m[0][0] = 0
+
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("GTTACGATTACA")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("CGTTGCGATTGCAC")), alphabet.DNAgapped)
+	aln, err := align.SW(m).Align(ref, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(aln)
+	fa := align.Format(ref, query, aln, '-')
+	fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	// Output:
+	// [[0,12)/[1,13)=18]
+	// GTTACGATTACA
+	// GTTGCGATTGCA
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestReadWrite(c *check.C) {
+	for _, test := range []struct {
+		file  string
+		alpha alphabet.Alphabet
+		want  [][]int
+	}{
+		{file: "NUC.4", alpha: alphabet.DNAgapped, want: NUC_4},
+		{file: "NUC.4.4", alpha: alphabet.DNAredundant, want: NUC_4_4},
+		{file: "BLOSUM62", alpha: alphabet.Protein, want: BLOSUM62},
+		{file: "PAM250", alpha: alphabet.Protein, want: PAM250},
+		{file: "GONNET", alpha: alphabet.Protein, want: GONNET},
+	} {
+		f, err := os.Open(filepath.Join("matrices", test.file))
+		c.Assert(err, check.Equals, nil)
+		m, err := Read(f, test.alpha)
+		f.Close()
+		c.Assert(err, check.Equals, nil, check.Commentf("%s", test.file))
+		c.Check(reflect.DeepEqual(m, test.want), check.Equals, true, check.Commentf("%s", test.file))
+
+		var buf bytes.Buffer
+		c.Assert(Write(&buf, m, test.alpha), check.Equals, nil)
+		got, err := Read(&buf, test.alpha)
+		c.Assert(err, check.Equals, nil)
+		c.Check(reflect.DeepEqual(got, m), check.Equals, true, check.Commentf("%s round trip", test.file))
+	}
+
+	var buf bytes.Buffer
+	c.Assert(Write(&buf, Match(alphabet.DNAgapped, -1, 2, -3), alphabet.DNAgapped), check.Equals, nil)
+	c.Check(buf.String(), check.Equals, `   A  C  G  T
+A  2 -3 -3 -3
+C -3  2 -3 -3
+G -3 -3  2 -3
+T -3 -3 -3  2
+`)
+	c.Check(Write(&buf, NUC_4, alphabet.Protein), check.ErrorMatches, "matrix: matrix size 5 does not match alphabet length 26")
+
+	for _, test := range []struct {
+		in  string
+		err string
+	}{
+		{in: "# comment only\n", err: "matrix: no matrix data"},
+		{in: "  A  C\nA 1 -1\nC -1\n", err: `matrix: row "C" has 1 scores, expected 2 at line 3`},
+		{in: "  A  C\nA 1 -1\nA -1 1\n", err: `matrix: duplicate row 'A' at line 3`},
+		{in: "  A  C\nA 1 x\nC -1 1\n", err: `matrix: invalid score "x" at line 2`},
+		{in: "  AC  G\nA 1 -1\nC -1 1\n", err: `matrix: invalid column letter "AC" at line 1`},
+		{in: "  A  C\nA 1 -1\n", err: "matrix: 1 rows for 2 columns"},
+	} {
+		_, err := Read(strings.NewReader(test.in), alphabet.DNAgapped)
+		c.Check(err, check.ErrorMatches, test.err)
+	}
+}
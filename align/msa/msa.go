@@ -0,0 +1,251 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package msa provides progressive multiple sequence alignment.
+package msa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
+	"github.com/biogo/biogo/phylo"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"fmt"
+)
+
+// Progressive is a progressive multiple sequence aligner. A guide tree is built from the
+// pairwise distances between the sequences to be aligned and the sequences are then aligned
+// in order of the tree, each internal node aligning the profiles of the alignments of its
+// children.
+type Progressive struct {
+	// Profile is the aligner used to align profiles.
+	Profile align.ProfileNW
+
+	// Distances returns the pairwise distances between
+	// sequences that are used to build the guide tree. If
+	// Distances is nil, AlignDistances is used with an
+	// align.NWAffine using the scoring matrix and gap
+	// penalties of Profile.
+	Distances func([]seq.Sequence) (*phylo.DistanceMatrix, error)
+
+	// Tree returns the guide tree for a distance matrix.
+	// If Tree is nil, phylo.UPGMA is used.
+	Tree func(*phylo.DistanceMatrix) (*phylo.Node, error)
+
+	// Consensus is the consensus function of the returned
+	// alignment. If Consensus is nil, seq.DefaultConsensus
+	// is used.
+	Consensus seq.ConsenseFunc
+}
+
+// GuideTree returns the guide tree for aligning seqs. The Taxon field of each leaf of the
+// returned tree is the index of the leaf's sequence in seqs.
+func (p Progressive) GuideTree(seqs []seq.Sequence) (*phylo.Node, error) {
+	if len(seqs) == 0 {
+		return nil, errors.New("msa: no sequences")
+	}
+	var (
+		d   *phylo.DistanceMatrix
+		err error
+	)
+	if p.Distances != nil {
+		d, err = p.Distances(seqs)
+	} else {
+		d, err = AlignDistances(seqs, p.pairwise())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if d.Len() != len(seqs) {
+		return nil, fmt.Errorf("msa: distance matrix size %d does not match number of sequences %d", d.Len(), len(seqs))
+	}
+	if p.Tree != nil {
+		return p.Tree(d)
+	}
+	return phylo.UPGMA(d)
+}
+
+// pairwise returns an affine gap aligner with the scoring matrix and gap penalties of the
+// receiver's profile aligner.
+func (p Progressive) pairwise() align.NWAffine {
+	m := make(align.Linear, len(p.Profile.Matrix))
+	for i, row := range p.Profile.Matrix {
+		m[i] = append([]int(nil), row...)
+		if i != 0 {
+			m[i][0] = p.Profile.GapExtend
+		}
+	}
+	if len(m) != 0 {
+		for j := 1; j < len(m[0]); j++ {
+			m[0][j] = p.Profile.GapExtend
+		}
+	}
+	return align.NWAffine{Matrix: m, GapOpen: p.Profile.GapOpen}
+}
+
+// Align returns a multiple alignment of seqs with the given id. The rows of the returned
+// alignment are copies of the sequences in seqs, in the same order, with gaps inserted.
+// The sequences in seqs must share an alphabet that includes the gap letter and must be
+// seq.Appenders.
+func (p Progressive) Align(id string, seqs []seq.Sequence) (*multi.Multi, error) {
+	t, err := p.GuideTree(seqs)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range t.Leaves() {
+		if l.Taxon < 0 || l.Taxon >= len(seqs) {
+			return nil, fmt.Errorf("msa: guide tree leaf %q has invalid taxon %d", l.Name, l.Taxon)
+		}
+	}
+	m, order, err := p.alignNode(t, seqs)
+	if err != nil {
+		return nil, err
+	}
+	if len(order) != len(seqs) {
+		return nil, errors.New("msa: guide tree does not include each sequence once")
+	}
+
+	rows := make([]seq.Sequence, len(seqs))
+	for k, i := range order {
+		if rows[i] != nil {
+			return nil, errors.New("msa: guide tree does not include each sequence once")
+		}
+		rows[i] = m.Seq[k]
+	}
+	m.Seq = rows
+	m.ID = id
+	m.ColumnConsense = p.Consensus
+	if m.ColumnConsense == nil {
+		m.ColumnConsense = seq.DefaultConsensus
+	}
+	return m, nil
+}
+
+// alignNode returns the alignment of the sequences at the leaves of the tree rooted at n
+// and the indices of the sequences in seqs for the rows of the alignment.
+func (p Progressive) alignNode(n *phylo.Node, seqs []seq.Sequence) (*multi.Multi, []int, error) {
+	if n.IsLeaf() {
+		m, err := multi.NewMulti("", []seq.Sequence{seqs[n.Taxon].Clone()}, nil)
+		return m, []int{n.Taxon}, err
+	}
+	m, order, err := p.alignNode(n.Children[0], seqs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range n.Children[1:] {
+		cm, co, err := p.alignNode(c, seqs)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err = p.Profile.AlignMulti(m, cm)
+		if err != nil {
+			return nil, nil, err
+		}
+		order = append(order, co...)
+	}
+	return m, order, nil
+}
+
+// AlignDistances returns the pairwise distances between the sequences in seqs calculated
+// as the fraction of aligned letter pairs that differ in the alignment of each pair of
+// sequences by a. If an alignment has no aligned letter pairs, the distance is 1. The
+// alignments are performed concurrently using an align.Batch.
+func AlignDistances(seqs []seq.Sequence, a align.Aligner) (*phylo.DistanceMatrix, error) {
+	d := phylo.NewDistanceMatrix(names(seqs))
+	var (
+		jobs []align.Job
+		idx  [][2]int
+	)
+	for i := range seqs {
+		for j := i + 1; j < len(seqs); j++ {
+			jobs = append(jobs, align.Job{Reference: seqs[i], Query: seqs[j]})
+			idx = append(idx, [2]int{i, j})
+		}
+	}
+	for k, r := range (align.Batch{Aligner: a}).AlignAll(jobs) {
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		i, j := idx[k][0], idx[k][1]
+		d.Set(i, j, mismatch(seqs[i], seqs[j], r.Pairs))
+	}
+	return d, nil
+}
+
+// mismatch returns the fraction of aligned letter pairs of a and b in the alignment
+// described by f that differ.
+func mismatch(a, b seq.Sequence, f []feat.Pair) float64 {
+	alpha := a.Alphabet()
+	var n, diff int
+	for _, fp := range f {
+		fs := fp.Features()
+		if fs[0].Len() == 0 || fs[1].Len() == 0 {
+			continue
+		}
+		for k := 0; k < fs[0].Len(); k++ {
+			la := a.At(a.Start() + fs[0].Start() + k).L
+			lb := b.At(b.Start() + fs[1].Start() + k).L
+			if alpha.IndexOf(la) != alpha.IndexOf(lb) {
+				diff++
+			}
+			n++
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return float64(diff) / float64(n)
+}
+
+// KmerDistances returns the pairwise distances between the nucleic acid sequences in seqs
+// calculated by kmerindex.Distance from their normalised k-mer frequencies. Gaps are removed
+// from the sequences before k-mers are counted and k-mers including ambiguous bases are
+// ignored.
+func KmerDistances(seqs []seq.Sequence, k int) (*phylo.DistanceMatrix, error) {
+	freqs := make([]map[kmerindex.Kmer]float64, len(seqs))
+	for i, s := range seqs {
+		var alpha alphabet.Alphabet
+		switch s.Alphabet().Moltype() {
+		case feat.DNA:
+			alpha = alphabet.DNA
+		case feat.RNA:
+			alpha = alphabet.RNA
+		default:
+			return nil, fmt.Errorf("msa: sequence %q is not a nucleic acid", s.Name())
+		}
+		gap := s.Alphabet().Gap()
+		l := make(alphabet.Letters, 0, s.Len())
+		for pos := s.Start(); pos < s.End(); pos++ {
+			if b := s.At(pos).L; b != gap {
+				l = append(l, b)
+			}
+		}
+		ki, err := kmerindex.New(k, linear.NewSeq(s.Name(), l, alpha))
+		if err != nil {
+			return nil, fmt.Errorf("msa: sequence %q: %v", s.Name(), err)
+		}
+		freqs[i], _ = ki.NormalisedKmerFrequencies()
+	}
+
+	d := phylo.NewDistanceMatrix(names(seqs))
+	for i := range seqs {
+		for j := i + 1; j < len(seqs); j++ {
+			d.Set(i, j, kmerindex.Distance(freqs[i], freqs[j]))
+		}
+	}
+	return d, nil
+}
+
+func names(seqs []seq.Sequence) []string {
+	n := make([]string, len(seqs))
+	for i, s := range seqs {
+		n[i] = s.Name()
+	}
+	return n
+}
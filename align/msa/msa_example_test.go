@@ -0,0 +1,46 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleProgressive_Align() {
+	var seqs []seq.Sequence
+	for _, s := range []struct{ id, seq string }{
+		{"human", "MKVLAAGIVGLLLAGCSS"},
+		{"mouse", "MKVLAAGLVGLLAGCSS"},
+		{"fly", "MRVLSAGIVALLGCSS"},
+		{"worm", "MKILSAGVVALLGCTS"},
+	} {
+		seqs = append(seqs, linear.NewSeq(s.id, alphabet.BytesToLetters([]byte(s.seq)), alphabet.Protein))
+	}
+
+	p := Progressive{Profile: align.ProfileNW{Matrix: matrix.BLOSUM62, GapOpen: -10, GapExtend: -1}}
+	m, err := p.Align("example", seqs)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%a\n", m)
+	fmt.Printf("%-s\n", m.Consensus(false))
+	// Output:
+	// >human
+	// MKVLAAGIVGLLLAGCSS
+	// >mouse
+	// MKVLAAGLVG-LLAGCSS
+	// >fly
+	// MRVLSAGIVA--LLGCSS
+	// >worm
+	// MKILSAGVVA--LLGCTS
+	// mkvlaagiva--lagcss
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msa
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/phylo"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func sequences(alpha alphabet.Alphabet, s ...string) []seq.Sequence {
+	seqs := make([]seq.Sequence, len(s))
+	for i, l := range s {
+		seqs[i] = linear.NewSeq(fmt.Sprint(i), alphabet.BytesToLetters([]byte(l)), alpha)
+	}
+	return seqs
+}
+
+func (s *S) TestAlignDistances(c *check.C) {
+	seqs := sequences(alphabet.DNAgapped, "acgtacgtac", "acgtacctac", "acgtac")
+	d, err := AlignDistances(seqs, align.NW(matrix.Match(alphabet.DNAgapped, -2, 2, -1)))
+	c.Assert(err, check.Equals, nil)
+	c.Check(d.Names, check.DeepEquals, []string{"0", "1", "2"})
+	c.Check(d.At(0, 1), check.Equals, 0.1)
+	c.Check(d.At(1, 0), check.Equals, 0.1)
+	c.Check(d.At(0, 2), check.Equals, 0.0)
+	c.Check(d.At(0, 0), check.Equals, 0.0)
+
+	_, err = AlignDistances(seqs, align.NW{{0}})
+	c.Check(err, check.NotNil)
+}
+
+func (s *S) TestKmerDistances(c *check.C) {
+	seqs := sequences(alphabet.DNAgapped, "acgtacgtacgt", "acgt-acgtacgt", "ttttttttgggg")
+	d, err := KmerDistances(seqs, 4)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d.At(0, 1) < 0.1, check.Equals, true, check.Commentf("gaps not removed: %v", d.At(0, 1)))
+	c.Check(d.At(0, 2) > d.At(0, 1), check.Equals, true)
+
+	_, err = KmerDistances(sequences(alphabet.Protein, "acdefghikl"), 4)
+	c.Check(err, check.ErrorMatches, `msa: sequence "0" is not a nucleic acid`)
+	_, err = KmerDistances(sequences(alphabet.DNAgapped, "acg"), 4)
+	c.Check(err, check.ErrorMatches, `msa: sequence "0": kmerindex: sequence to short for k`)
+}
+
+func (s *S) TestProgressive(c *check.C) {
+	seqs := sequences(alphabet.DNAgapped,
+		"acgtacgtaacgttgca",
+		"acgtacgtacgttgca",
+		"ttgacgtacgttgcaa",
+		"acgtacgtaacgttgca",
+	)
+	orig := make([]string, len(seqs))
+	for i, s := range seqs {
+		orig[i] = fmt.Sprintf("%-s", s)
+	}
+	var (
+		profile = align.ProfileNW{Matrix: matrix.NUC_4, GapOpen: -10, GapExtend: -1}
+		kmer    = func(s []seq.Sequence) (*phylo.DistanceMatrix, error) { return KmerDistances(s, 4) }
+	)
+	for _, p := range []Progressive{
+		{Profile: profile},
+		{Profile: profile, Tree: phylo.NeighborJoining},
+		{Profile: profile, Distances: kmer},
+	} {
+		t, err := p.GuideTree(seqs)
+		c.Assert(err, check.Equals, nil)
+		c.Check(len(t.Leaves()), check.Equals, len(seqs))
+
+		m, err := p.Align("test", seqs)
+		c.Assert(err, check.Equals, nil)
+		c.Check(m.ID, check.Equals, "test")
+		c.Assert(m.Rows(), check.Equals, len(seqs))
+		var rows []string
+		for i, r := range m.Seq {
+			c.Check(r.Name(), check.Equals, seqs[i].Name())
+			rows = append(rows, fmt.Sprintf("%-s", r))
+		}
+		c.Check(rows, check.DeepEquals, []string{
+			"acgtacgtaacgttgc-a",
+			"acgtacgt-acgttgc-a",
+			"ttg-acgt-acgttgcaa",
+			"acgtacgtaacgttgc-a",
+		})
+		for i, s := range seqs {
+			c.Check(fmt.Sprintf("%-s", s), check.Equals, orig[i], check.Commentf("input sequence altered"))
+		}
+	}
+
+	m, err := (Progressive{Profile: profile}).Align("single", seqs[:1])
+	c.Assert(err, check.Equals, nil)
+	c.Check(m.Rows(), check.Equals, 1)
+	c.Check(fmt.Sprintf("%-s", m.Row(0)), check.Equals, orig[0])
+	c.Check(m.Row(0), check.Not(check.Equals), seqs[0])
+
+	_, err = (Progressive{Profile: profile}).Align("empty", nil)
+	c.Check(err, check.ErrorMatches, "msa: no sequences")
+}
@@ -18,6 +18,11 @@ type NWAffine Affine
 // Align aligns two sequences using the Needleman-Wunsch algorithm. It returns an alignment description
 // or an error if the scoring matrix is not square, or the sequence data types or alphabets do not match.
 func (a NWAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	return a.align(reference, query, nil)
+}
+
+// align aligns two sequences using buf to hold the dynamic programming table.
+func (a NWAffine) align(reference, query AlphabetSlicer, buf *tables) ([]feat.Pair, error) {
 	alpha := reference.Alphabet()
 	if alpha == nil {
 		return nil, ErrNoAlphabet
@@ -34,13 +39,13 @@ func (a NWAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignLetters(rSeq, qSeq, alpha)
+		return a.alignLetters(rSeq, qSeq, alpha, buf)
 	case alphabet.QLetters:
 		qSeq, ok := query.Slice().(alphabet.QLetters)
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignQLetters(rSeq, qSeq, alpha)
+		return a.alignQLetters(rSeq, qSeq, alpha, buf)
 	default:
 		return nil, ErrTypeNotHandled
 	}
@@ -95,7 +95,7 @@ func pointerNWAffineLetters(rSeq, qSeq alphabet.Letters, i, j, l int, table [][3
 	}
 }
 
-func (a NWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a NWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
@@ -110,7 +110,7 @@ func (a NWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alpha
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 	table[0] = [3]int{
 		diag: 0,
 		up:   minInt,
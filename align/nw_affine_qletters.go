@@ -95,7 +95,7 @@ func pointerNWAffineQLetters(rSeq, qSeq alphabet.QLetters, i, j, l int, table []
 	}
 }
 
-func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
@@ -110,7 +110,7 @@ func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alp
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 	table[0] = [3]int{
 		diag: 0,
 		up:   minInt,
@@ -81,3 +81,190 @@ func ExampleNWAffine_Align() {
 	// ATAGGAA--G
 	// ATTGGCAATG
 }
+
+func ExampleNWBanded_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	//
+	// The band must include diagonals 0 and len(query)-len(reference), so
+	// centre it between them.
+	needle := NWBanded{
+		Matrix: Linear{
+			{0, -5, -5, -5, -5},
+			{-5, 10, -3, -1, -4},
+			{-5, -3, 9, -5, 0},
+			{-5, -1, -5, 7, -3},
+			{-5, -4, 0, -3, 8},
+		},
+		Width:  1,
+		Centre: -1,
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,1)/-=-5 [1,4)/[0,3)=26 [4,5)/-=-5 [5,10)/[3,8)=12]
+	// AGACTAGTTA
+	// -GAC-AGACG
+}
+
+func ExampleHirschberg_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	needle := Hirschberg{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,1)/-=-5 [1,4)/[0,3)=26 [4,5)/-=-5 [5,10)/[3,8)=12]
+	// AGACTAGTTA
+	// -GAC-AGACG
+}
+
+func ExampleHirschbergAffine_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATAGGAAG"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATTGGCAATG"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-1	-1	-1	-1
+	// A	-1	 1	-1	-1	-1
+	// C	-1	-1	 1	-1	-1
+	// G	-1	-1	-1	 1	-1
+	// T	-1	-1	-1	-1	 1
+	//
+	// Gap open: -5
+	needle := HirschbergAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		GapOpen: -5,
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,7)/[0,7)=3 -/[7,9)=-7 [7,8)/[9,10)=1]
+	// ATAGGAA--G
+	// ATTGGCAATG
+}
+
+func ExampleNWQuality_Align() {
+	qual := func(s string, q []alphabet.Qphred) *linear.QSeq {
+		ql := make([]alphabet.QLetter, len(s))
+		for i := range s {
+			ql[i] = alphabet.QLetter{L: alphabet.Letter(s[i]), Q: q[i]}
+		}
+		return linear.NewQSeq("", ql, alphabet.DNAgapped, alphabet.Sanger)
+	}
+	nwsa := qual("ACGTACGT", []alphabet.Qphred{40, 40, 40, 40, 40, 40, 40, 40})
+	nwsb := qual("ACGTTCGT", []alphabet.Qphred{40, 40, 40, 40, 5, 40, 40, 40})
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-50	-50	-50	-50
+	// A	-50	 50	-50	-50	-50
+	// C	-50	-50	 50	-50	-50
+	// G	-50	-50	-50	 50	-50
+	// T	-50	-50	-50	-50	 50
+	needle := NWQuality{
+		{0, -50, -50, -50, -50},
+		{-50, 50, -50, -50, -50},
+		{-50, -50, 50, -50, -50},
+		{-50, -50, -50, 50, -50},
+		{-50, -50, -50, -50, 50},
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+	}
+	// Output:
+	// [[0,8)/[0,8)=316]
+}
+
+func ExampleNWDualAffine_Align() {
+	nwsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACGTTGCATGTCGCATGATGCATGAGAGCTGACGTAGCATCGA"))}
+	nwsa.Alpha = alphabet.DNAgapped
+	nwsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACGTTGCATCGA"))}
+	nwsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	//
+	// Gap open: -10
+	// Long gap open: -40
+	// Long gap extend: -1
+	needle := NWDualAffine{
+		Matrix: Linear{
+			{0, -5, -5, -5, -5},
+			{-5, 10, -3, -1, -4},
+			{-5, -3, 9, -5, 0},
+			{-5, -1, -5, 7, -3},
+			{-5, -4, 0, -3, 8},
+		},
+		GapOpen:       -10,
+		LongGapOpen:   -40,
+		LongGapExtend: -1,
+	}
+
+	aln, err := needle.Align(nwsa, nwsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(nwsa, nwsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,9)/[0,9)=76 [9,40)/-=-71 [40,43)/[9,12)=26]
+	// ACGTTGCATGTCGCATGATGCATGAGAGCTGACGTAGCATCGA
+	// ACGTTGCAT-------------------------------CGA
+}
@@ -72,7 +72,7 @@ func pointerNWQLetters(rSeq, qSeq alphabet.QLetters, i, j int, table []int, inde
 	}
 }
 
-func (a NW) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a NW) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
@@ -87,7 +87,7 @@ func (a NW) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet)
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([]int, r*c)
+	table := buf.linear(r * c)
 	for j := range table[1:c] {
 		table[j+1] = table[j] + la[index[qSeq[j].L]]
 	}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+)
+
+// Setting debugOverlap to true gives verbose scoring table output for the dynamic programming.
+const debugOverlap = false
+
+// Overlap is the linear gap penalty overlap Needleman-Wunsch aligner type.
+type Overlap Linear
+
+// Align aligns two sequences using a modified Needleman-Wunsch algorithm that finds the best overlap of
+// the end of one sequence with the start of the other, or of one sequence contained within the other.
+// Leading and trailing gaps in both sequences are not penalised and are not included in the returned
+// alignment description. It returns an alignment description or an error if the scoring matrix is not
+// square, or the sequence data types or alphabets do not match.
+func (a Overlap) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	return a.align(reference, query, nil)
+}
+
+// align aligns two sequences using buf to hold the dynamic programming table.
+func (a Overlap) align(reference, query AlphabetSlicer, buf *tables) ([]feat.Pair, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	switch rSeq := reference.Slice().(type) {
+	case alphabet.Letters:
+		qSeq, ok := query.Slice().(alphabet.Letters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignLetters(rSeq, qSeq, alpha, buf)
+	case alphabet.QLetters:
+		qSeq, ok := query.Slice().(alphabet.QLetters)
+		if !ok {
+			return nil, ErrMismatchedTypes
+		}
+		return a.alignQLetters(rSeq, qSeq, alpha, buf)
+	default:
+		return nil, ErrTypeNotHandled
+	}
+}
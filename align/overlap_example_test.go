@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleOverlap_Align() {
+	osa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GTTGACAGACTAGATTCACG"))}
+	osa.Alpha = alphabet.DNAgapped
+	osb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GATTCACGTTTGCAA"))}
+	osb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	overlap := Overlap{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := overlap.Align(osa, osb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(osa, osb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[12,20)/[0,8)=68]
+	// GATTCACG
+	// GATTCACG
+}
+
+func ExampleOverlapAffine_Align() {
+	osa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("TTTTTATTGGCAATGA"))}
+	osa.Alpha = alphabet.DNAgapped
+	osb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GGCATGACCCCC"))}
+	osb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-1	-1	-1	-1
+	// A	-1	 1	-1	-1	-1
+	// C	-1	-1	 1	-1	-1
+	// G	-1	-1	-1	 1	-1
+	// T	-1	-1	-1	-1	 1
+	//
+	// Gap open: -3
+	overlap := OverlapAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		GapOpen: -3,
+	}
+
+	aln, err := overlap.Align(osa, osb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(osa, osb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[8,12)/[0,4)=4 [12,13)/-=-4 [13,16)/[4,7)=3]
+	// GGCAATGA
+	// GGCA-TGA
+}
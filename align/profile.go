@@ -0,0 +1,312 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A Profile is a column frequency summary of a multiple alignment.
+type Profile struct {
+	Alpha alphabet.Alphabet
+
+	// Freqs holds the frequency of each letter in each column of
+	// the alignment, indexed by column and then by letter index of
+	// Alpha. Frequencies are fractions of the number of rows in the
+	// alignment, so the frequency of the gap letter is the fraction
+	// of rows with a gap in the column.
+	Freqs [][]float64
+}
+
+// NewProfile returns a Profile of the multiple alignment a, which must hold letters of alpha.
+// Rows that do not cover a column of a are counted as gaps in that column.
+func NewProfile(a seq.Aligned, alpha alphabet.Alphabet) (*Profile, error) {
+	if a.Rows() == 0 {
+		return nil, errors.New("align: empty alignment")
+	}
+	index := alpha.LetterIndex()
+	p := &Profile{Alpha: alpha, Freqs: make([][]float64, 0, a.End()-a.Start())}
+	for pos := a.Start(); pos < a.End(); pos++ {
+		col := a.Column(pos, true)
+		f := make([]float64, alpha.Len())
+		w := 1 / float64(len(col))
+		for _, l := range col {
+			i := index[l]
+			if i < 0 {
+				return nil, fmt.Errorf("align: illegal letter %q in column %d", l, pos)
+			}
+			f[i] += w
+		}
+		p.Freqs = append(p.Freqs, f)
+	}
+	return p, nil
+}
+
+// Len returns the number of columns in the profile.
+func (p *Profile) Len() int { return len(p.Freqs) }
+
+// Occupancy returns the fraction of rows of the profile's alignment that do not have a gap
+// in column i.
+func (p *Profile) Occupancy(i int) float64 {
+	return 1 - p.Freqs[i][p.Alpha.IndexOf(p.Alpha.Gap())]
+}
+
+// ProfileNW is the global profile aligner type. It aligns multiple alignments, each
+// summarised by a Profile, so that new sequences can be aligned to an existing multiple
+// alignment and alignments can be merged.
+//
+// A pair of columns is scored by the frequency weighted sum of the Matrix scores for each
+// pair of letters in the columns; the gap penalties in the first row and column of Matrix
+// are not used. A gap of k columns is scored GapOpen + k×GapExtend, with the contribution
+// of each column scaled by the column's occupancy, so gaps are cheaper opposite columns
+// that are already mostly gaps.
+type ProfileNW struct {
+	Matrix    Linear
+	GapOpen   int
+	GapExtend int
+}
+
+// AlignProfiles performs global alignment of the reference and query profiles, returning
+// an ordered slice of feature pairs describing matching and gapped segments in column
+// coordinates of the profiles. The scores of the feature pairs are rounded to the nearest
+// integer.
+func (a ProfileNW) AlignProfiles(reference, query *Profile) ([]feat.Pair, error) {
+	if reference.Alpha != query.Alpha {
+		return nil, errors.New("align: profile alphabets do not match")
+	}
+	alpha := reference.Alpha
+	la, let, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	g := alpha.IndexOf(alpha.Gap())
+
+	// rs holds the frequency weighted matrix rows of each reference
+	// column and qf holds the non-zero letter frequencies of each
+	// query column so that column pairs can be scored in time
+	// proportional to the number of distinct letters in the query
+	// column.
+	rs := make([][]float64, reference.Len())
+	for i, f := range reference.Freqs {
+		rs[i] = make([]float64, alpha.Len())
+		for k, v := range f {
+			if v == 0 || k == g {
+				continue
+			}
+			for l := range rs[i] {
+				if l != g {
+					rs[i][l] += v * float64(la[k*let+l])
+				}
+			}
+		}
+	}
+	type freq struct {
+		index int
+		f     float64
+	}
+	qf := make([][]freq, query.Len())
+	for j, f := range query.Freqs {
+		for l, v := range f {
+			if v != 0 && l != g {
+				qf[j] = append(qf[j], freq{index: l, f: v})
+			}
+		}
+	}
+	score := func(i, j int) float64 {
+		var s float64
+		for _, q := range qf[j] {
+			s += rs[i][q.index] * q.f
+		}
+		return s
+	}
+
+	var (
+		r, c   = reference.Len() + 1, query.Len() + 1
+		open   = float64(a.GapOpen)
+		extend = float64(a.GapExtend)
+		inf    = math.Inf(-1)
+
+		table = make([][3]float64, r*c)
+		from  = make([][3]byte, r*c)
+	)
+	table[0] = [3]float64{diag: 0, up: inf, left: inf}
+	for i := 1; i < r; i++ {
+		p := i * c
+		occ := reference.Occupancy(i - 1)
+		table[p] = [3]float64{diag: inf, left: inf}
+		table[p][up], from[p][up] = best2(table[p-c][diag]+occ*(open+extend), diag, table[p-c][up]+occ*extend, up)
+	}
+	for j := 1; j < c; j++ {
+		occ := query.Occupancy(j - 1)
+		table[j] = [3]float64{diag: inf, up: inf}
+		table[j][left], from[j][left] = best2(table[j-1][diag]+occ*(open+extend), diag, table[j-1][left]+occ*extend, left)
+	}
+	for i := 1; i < r; i++ {
+		rOcc := reference.Occupancy(i - 1)
+		for j := 1; j < c; j++ {
+			qOcc := query.Occupancy(j - 1)
+			p := i*c + j
+
+			t := table[p-c-1]
+			s, l := best2(t[diag], diag, t[up], up)
+			s, l = best2(s, l, t[left], left)
+			table[p][diag], from[p][diag] = s+score(i-1, j-1), l
+
+			table[p][up], from[p][up] = best2(
+				table[p-c][diag]+rOcc*(open+extend), diag,
+				table[p-c][up]+rOcc*extend, up,
+			)
+			table[p][left], from[p][left] = best2(
+				table[p-1][diag]+qOcc*(open+extend), diag,
+				table[p-1][left]+qOcc*extend, left,
+			)
+		}
+	}
+
+	i, j := r-1, c-1
+	_, layer := best2(table[i*c+j][diag], diag, table[i*c+j][up], up)
+	_, layer = best2(table[i*c+j][layer], layer, table[i*c+j][left], left)
+	var (
+		aln        []feat.Pair
+		end        = table[i*c+j][layer]
+		maxI, maxJ = i, j
+	)
+	for i > 0 || j > 0 {
+		p := i*c + j
+		next := from[p][layer]
+		switch layer {
+		case diag:
+			i--
+			j--
+		case up:
+			i--
+		case left:
+			j--
+		}
+		if i == 0 && j == 0 || next != layer {
+			s := table[i*c+j][next]
+			if i == 0 && j == 0 {
+				s = 0
+			}
+			aln = append(aln, &featPair{
+				a:     feature{start: i, end: maxI},
+				b:     feature{start: j, end: maxJ},
+				score: int(math.Round(end - s)),
+			})
+			maxI, maxJ, end = i, j, s
+		}
+		layer = next
+	}
+	for i, j := 0, len(aln)-1; i < j; i, j = i+1, j-1 {
+		aln[i], aln[j] = aln[j], aln[i]
+	}
+	return aln, nil
+}
+
+// best2 returns the larger of a and b and the move associated with it, preferring a.
+func best2(a float64, ma byte, b float64, mb byte) (float64, byte) {
+	if b > a {
+		return b, mb
+	}
+	return a, ma
+}
+
+// AlignSeq aligns the sequence s to the multiple alignment m and returns a new multiple
+// alignment holding the rows of m followed by s. The letters of the returned alignment are
+// appended to copies of the rows of m and of s using AppendColumns.
+func (a ProfileNW) AlignSeq(m *multi.Multi, s seq.Sequence) (*multi.Multi, error) {
+	q, err := multi.NewMulti(s.Name(), []seq.Sequence{s}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return a.AlignMulti(m, q)
+}
+
+// AlignMulti aligns the multiple alignments reference and query and returns a new multiple
+// alignment holding the rows of reference followed by the rows of query. The letters of the
+// returned alignment are appended to copies of the rows of reference and query using
+// AppendColumns. The rows of reference and query must be seq.Appenders.
+func (a ProfileNW) AlignMulti(reference, query *multi.Multi) (*multi.Multi, error) {
+	if reference.Alpha != query.Alpha {
+		return nil, errors.New("align: multiple alignment alphabets do not match")
+	}
+	rp, err := NewProfile(reference, reference.Alpha)
+	if err != nil {
+		return nil, err
+	}
+	qp, err := NewProfile(query, query.Alpha)
+	if err != nil {
+		return nil, err
+	}
+	aln, err := a.AlignProfiles(rp, qp)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]seq.Sequence, 0, reference.Rows()+query.Rows())
+	for _, m := range []*multi.Multi{reference, query} {
+		for _, r := range m.Seq {
+			if _, ok := r.(seq.Appender); !ok {
+				return nil, fmt.Errorf("align: cannot append to row of type %T", r)
+			}
+			n := r.Clone()
+			n.SetSlice(n.Slice().Make(0, rp.Len()+qp.Len()))
+			n.SetOffset(0)
+			rows = append(rows, n)
+		}
+	}
+	m, err := multi.NewMulti(reference.ID, rows, reference.ColumnConsense)
+	if err != nil {
+		return nil, err
+	}
+	m.Encode = reference.Encode
+	err = AppendAligned(m, reference, query, aln)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AppendAligned appends the columns of the alignment of the multiple alignments a and b
+// described by the feature pairs in f, as returned by ProfileNW.AlignProfiles for Profiles of
+// a and b, to dst using AppendColumns. Each column appended to dst holds the rows of a
+// followed by the rows of b with gaps filled by the gap letter of the alphabet of dst.
+func AppendAligned(dst seq.AlignedAppender, a, b seq.Aligned, f []feat.Pair) error {
+	alpha, ok := dst.(interface{ Alphabet() alphabet.Alphabet })
+	if !ok {
+		return errors.New("align: cannot determine alphabet of destination alignment")
+	}
+	gap := alphabet.QLetter{L: alpha.Alphabet().Gap()}
+	var cols [][]alphabet.QLetter
+	for _, fp := range f {
+		fs := fp.Features()
+		fa, fb := fs[0], fs[1]
+		if fa.Len() != 0 && fb.Len() != 0 && fa.Len() != fb.Len() {
+			return fmt.Errorf("align: feature pair lengths do not match: %d != %d", fa.Len(), fb.Len())
+		}
+		for k := 0; k < fa.Len() || k < fb.Len(); k++ {
+			col := make([]alphabet.QLetter, 0, a.Rows()+b.Rows())
+			if fa.Len() != 0 {
+				col = append(col, a.ColumnQL(a.Start()+fa.Start()+k, true)...)
+			} else {
+				col = append(col, gap.Repeat(a.Rows())...)
+			}
+			if fb.Len() != 0 {
+				col = append(col, b.ColumnQL(b.Start()+fb.Start()+k, true)...)
+			} else {
+				col = append(col, gap.Repeat(b.Rows())...)
+			}
+			cols = append(cols, col)
+		}
+	}
+	return dst.AppendColumns(cols...)
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"fmt"
+)
+
+func ExampleProfileNW_AlignSeq() {
+	var rows []seq.Sequence
+	for _, s := range []struct{ id, seq string }{
+		{"a", "MKV-LAAGW"},
+		{"b", "MKVILASGW"},
+		{"c", "MRV-LAAGW"},
+	} {
+		rows = append(rows, linear.NewSeq(s.id, alphabet.BytesToLetters([]byte(s.seq)), alphabet.Protein))
+	}
+	m, err := multi.NewMulti("msa", rows, seq.DefaultConsensus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	pnw := ProfileNW{Matrix: matrix.BLOSUM62, GapOpen: -10, GapExtend: -1}
+	aln, err := pnw.AlignSeq(m, linear.NewSeq("d", alphabet.BytesToLetters([]byte("MKVLASGW")), alphabet.Protein))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%a\n", aln)
+	// Output:
+	// >a
+	// MKV-LAAGW
+	// >b
+	// MKVILASGW
+	// >c
+	// MRV-LAAGW
+	// >d
+	// MKV-LASGW
+}
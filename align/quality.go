@@ -0,0 +1,422 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+// SWQuality is the linear gap penalty Smith-Waterman aligner type for quality scored
+// sequences. The substitution score of each pair of aligned letters is weighted by the
+// probability that both letters were called correctly, (1-e_r)(1-e_q), where e_r and
+// e_q are the error probabilities of the Phred qualities of the letters, and rounded
+// to the nearest integer. Low quality mismatches are therefore penalised less than high
+// quality mismatches. Gap scores are not weighted. Since weighted scores are rounded,
+// the scoring matrix should be scaled so that its scores are large enough to be weighted
+// with adequate resolution. Sequences without quality scores are aligned by SW.
+type SWQuality Linear
+
+// Align aligns two sequences using the Smith-Waterman algorithm with quality weighted
+// substitution scores. It returns an alignment description or an error if the scoring
+// matrix is not square, or the sequence data types or alphabets do not match.
+func (a SWQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := newQuality(Linear(a), 0, reference, query)
+	if err == errNoQuality {
+		return SW(a).Align(reference, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.alignLinear(true), nil
+}
+
+// NWQuality is the linear gap penalty Needleman-Wunsch aligner type for quality scored
+// sequences. Substitution scores are weighted as described for SWQuality. Sequences
+// without quality scores are aligned by NW.
+type NWQuality Linear
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm with quality weighted
+// substitution scores. It returns an alignment description or an error if the scoring
+// matrix is not square, or the sequence data types or alphabets do not match.
+func (a NWQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := newQuality(Linear(a), 0, reference, query)
+	if err == errNoQuality {
+		return NW(a).Align(reference, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.alignLinear(false), nil
+}
+
+// SWAffineQuality is the affine gap penalty Smith-Waterman aligner type for quality
+// scored sequences. Substitution scores are weighted as described for SWQuality.
+// Sequences without quality scores are aligned by SWAffine.
+type SWAffineQuality Affine
+
+// Align aligns two sequences using the Smith-Waterman algorithm with quality weighted
+// substitution scores. It returns an alignment description or an error if the scoring
+// matrix is not square, or the sequence data types or alphabets do not match.
+func (a SWAffineQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := newQuality(a.Matrix, a.GapOpen, reference, query)
+	if err == errNoQuality {
+		return SWAffine(a).Align(reference, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.alignAffine(true), nil
+}
+
+// NWAffineQuality is the affine gap penalty Needleman-Wunsch aligner type for quality
+// scored sequences. Substitution scores are weighted as described for SWQuality.
+// Sequences without quality scores are aligned by NWAffine.
+type NWAffineQuality Affine
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm with quality weighted
+// substitution scores. It returns an alignment description or an error if the scoring
+// matrix is not square, or the sequence data types or alphabets do not match.
+func (a NWAffineQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := newQuality(a.Matrix, a.GapOpen, reference, query)
+	if err == errNoQuality {
+		return NWAffine(a).Align(reference, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.alignAffine(false), nil
+}
+
+// QualityScore returns the substitution score s weighted by the probability that
+// letters with the Phred qualities qr and qq were both called correctly.
+func QualityScore(s int, qr, qq alphabet.Qphred) int {
+	return weighted(s, (1-qr.ProbE())*(1-qq.ProbE()))
+}
+
+func weighted(s int, w float64) int {
+	return int(math.Round(float64(s) * w))
+}
+
+// errNoQuality is returned by newQuality when the sequences are not quality scored.
+var errNoQuality = errors.New("align: no quality")
+
+// quality holds the state for a quality weighted alignment.
+type quality struct {
+	la   []int
+	let  int
+	open int
+
+	rSeq, qSeq []int
+
+	// rW and qW hold the probabilities that
+	// the letters were called correctly.
+	rW, qW []float64
+}
+
+// newQuality returns a quality weighted aligner for the reference and query. If the
+// sequences are not QLetters, newQuality returns errNoQuality.
+func newQuality(m Linear, open int, reference, query AlphabetSlicer) (*quality, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	rSeq, ok := reference.Slice().(alphabet.QLetters)
+	if !ok {
+		return nil, errNoQuality
+	}
+	qSeq, ok := query.Slice().(alphabet.QLetters)
+	if !ok {
+		return nil, ErrMismatchedTypes
+	}
+	la, let, err := flatten(m, alpha)
+	if err != nil {
+		return nil, err
+	}
+	index := alpha.LetterIndex()
+	rIdx, err := indicesOfQLetters(rSeq, index, "rSeq")
+	if err != nil {
+		return nil, err
+	}
+	qIdx, err := indicesOfQLetters(qSeq, index, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+	return &quality{
+		la: la, let: let, open: open,
+		rSeq: rIdx, qSeq: qIdx,
+		rW: weights(rSeq), qW: weights(qSeq),
+	}, nil
+}
+
+func weights(s alphabet.QLetters) []float64 {
+	w := make([]float64, len(s))
+	for i, l := range s {
+		w[i] = 1 - l.Q.ProbE()
+	}
+	return w
+}
+
+// match returns the weighted substitution score for rSeq[i] and qSeq[j].
+func (q *quality) match(i, j int) int {
+	return weighted(q.la[q.rSeq[i]*q.let+q.qSeq[j]], q.rW[i]*q.qW[j])
+}
+
+// alignLinear returns the local or global linear gap penalty alignment of the sequences,
+// resolving ties in the same way as SW and NW.
+func (q *quality) alignLinear(local bool) []feat.Pair {
+	la, let := q.la, q.let
+	r, c := len(q.rSeq)+1, len(q.qSeq)+1
+	table := make([]int, r*c)
+	if !local {
+		for j := 1; j < c; j++ {
+			table[j] = table[j-1] + la[q.qSeq[j-1]]
+		}
+		for i := 1; i < r; i++ {
+			table[i*c] = table[(i-1)*c] + la[q.rSeq[i-1]*let]
+		}
+	}
+
+	maxS, maxI, maxJ := 0, 0, 0
+	for i := 1; i < r; i++ {
+		rVal := q.rSeq[i-1]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			diagScore := table[p-c-1] + q.match(i-1, j-1)
+			score := max3(
+				diagScore,
+				table[p-c]+la[rVal*let],
+				table[p-1]+la[q.qSeq[j-1]],
+			)
+			if local {
+				if score > 0 {
+					if score >= maxS && score == diagScore {
+						maxS, maxI, maxJ = score, i, j
+					}
+				} else {
+					score = 0
+				}
+			}
+			table[p] = score
+		}
+	}
+	if !local {
+		maxI, maxJ = r-1, c-1
+	}
+
+	var moves []byte
+	i, j := maxI, maxJ
+	for i > 0 && j > 0 {
+		p := i*c + j
+		if local && table[p] == 0 {
+			break
+		}
+		switch table[p] {
+		case table[p-c-1] + q.match(i-1, j-1):
+			moves = append(moves, diag)
+			i--
+			j--
+		case table[p-c] + la[q.rSeq[i-1]*let]:
+			moves = append(moves, up)
+			i--
+		case table[p-1] + la[q.qSeq[j-1]]:
+			moves = append(moves, left)
+			j--
+		default:
+			panic(fmt.Sprintf("align: quality internal error: no path at row: %d col:%d\n", i, j))
+		}
+	}
+	if !local {
+		for ; i > 0; i-- {
+			moves = append(moves, up)
+		}
+		for ; j > 0; j-- {
+			moves = append(moves, left)
+		}
+	}
+	reverseMoves(moves)
+
+	return q.pairs(moves, i, j)
+}
+
+// alignAffine returns the local or global affine gap penalty alignment of the sequences
+// using the dynamic programming layers of SWAffine and NWAffine.
+func (q *quality) alignAffine(local bool) []feat.Pair {
+	la, let, open := q.la, q.let, q.open
+	r, c := len(q.rSeq)+1, len(q.qSeq)+1
+	table := make([][3]int, r*c)
+	if !local {
+		table[0] = [3]int{diag: 0, up: minInt, left: minInt}
+		for j := 1; j < c; j++ {
+			table[j] = [3]int{
+				diag: minInt,
+				up:   minInt,
+				left: add(max2(add(table[j-1][diag], open), table[j-1][left]), la[q.qSeq[j-1]]),
+			}
+		}
+		for i := 1; i < r; i++ {
+			p := i * c
+			table[p] = [3]int{
+				diag: minInt,
+				up:   add(max2(add(table[p-c][diag], open), table[p-c][up]), la[q.rSeq[i-1]*let]),
+				left: minInt,
+			}
+		}
+	}
+
+	maxS, maxI, maxJ := 0, 0, 0
+	for i := 1; i < r; i++ {
+		rGap := la[q.rSeq[i-1]*let]
+		for j := 1; j < c; j++ {
+			qGap := la[q.qSeq[j-1]]
+			p := i*c + j
+			prev := table[p-c-1]
+			s := max3(prev[diag], prev[up], prev[left])
+			score := [3]int{
+				diag: add(s, q.match(i-1, j-1)),
+				up:   max2(add(table[p-c][diag], open+rGap), add(table[p-c][up], rGap)),
+				left: max2(add(table[p-1][diag], open+qGap), add(table[p-1][left], qGap)),
+			}
+			if local {
+				for l, v := range score {
+					score[l] = max2(v, 0)
+				}
+				if score[diag] > 0 && score[diag] >= maxS && s == prev[diag] {
+					maxS, maxI, maxJ = score[diag], i, j
+				}
+			}
+			table[p] = score
+		}
+	}
+
+	layer := diag
+	if !local {
+		maxI, maxJ = r-1, c-1
+		t := table[maxI*c+maxJ]
+		for l, s := range t[1:] {
+			if s > t[layer] {
+				layer = l + 1
+			}
+		}
+	}
+
+	var moves []byte
+	i, j := maxI, maxJ
+	for i > 0 && j > 0 {
+		p := i*c + j
+		score := table[p][layer]
+		if local && score == 0 {
+			break
+		}
+		moves = append(moves, byte(layer))
+		switch layer {
+		case diag:
+			s := q.match(i-1, j-1)
+			switch score {
+			case add(table[p-c-1][up], s):
+				layer = up
+			case add(table[p-c-1][left], s):
+				layer = left
+			case add(table[p-c-1][diag], s):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: quality affine internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			i--
+			j--
+		case up:
+			g := la[q.rSeq[i-1]*let]
+			switch score {
+			case add(table[p-c][up], g):
+				layer = up
+			case add(table[p-c][diag], open+g):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: quality affine internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			i--
+		case left:
+			g := la[q.qSeq[j-1]]
+			switch score {
+			case add(table[p-1][left], g):
+				layer = left
+			case add(table[p-1][diag], open+g):
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: quality affine internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			j--
+		}
+	}
+	if !local {
+		for ; i > 0; i-- {
+			moves = append(moves, up)
+		}
+		for ; j > 0; j-- {
+			moves = append(moves, left)
+		}
+	}
+	reverseMoves(moves)
+
+	return q.pairs(moves, i, j)
+}
+
+// pairs returns the feature pairs described by the alignment path in moves starting
+// from (i, j).
+func (q *quality) pairs(moves []byte, i, j int) []feat.Pair {
+	var (
+		aln []feat.Pair
+
+		score  int
+		last   = byte(diag)
+		si, sj = i, j
+	)
+	for n, m := range moves {
+		if n != 0 && m != last {
+			aln = append(aln, &featPair{
+				a:     feature{start: si, end: i},
+				b:     feature{start: sj, end: j},
+				score: score,
+			})
+			si, sj = i, j
+			score = 0
+		}
+		switch m {
+		case diag:
+			score += q.match(i, j)
+			i++
+			j++
+		case up:
+			if last != up {
+				score += q.open
+			}
+			score += q.la[q.rSeq[i]*q.let]
+			i++
+		case left:
+			if last != left {
+				score += q.open
+			}
+			score += q.la[q.qSeq[j]]
+			j++
+		}
+		last = m
+	}
+	aln = append(aln, &featPair{
+		a:     feature{start: si, end: i},
+		b:     feature{start: sj, end: j},
+		score: score,
+	})
+	return aln
+}
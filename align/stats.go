@@ -0,0 +1,543 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+var (
+	ErrPositiveExpectedScore = errors.New("align: expected score is not negative")
+	ErrNoPositiveScore       = errors.New("align: no positive score possible")
+)
+
+// KarlinAltschul holds the Karlin-Altschul statistical parameters of a local alignment
+// scoring system.
+type KarlinAltschul struct {
+	Lambda float64 // Scale parameter in nats per unit score.
+	K      float64 // Search space scale parameter.
+	H      float64 // Relative entropy of aligned pairs in nats per aligned pair.
+}
+
+// BitScore returns the normalised score in bits of the raw score s.
+func (p KarlinAltschul) BitScore(s int) float64 {
+	return (p.Lambda*float64(s) - math.Log(p.K)) / math.Ln2
+}
+
+// EValue returns the expected number of distinct local alignments with a score of at
+// least s between a query of length m and a database of total length n.
+func (p KarlinAltschul) EValue(s, m, n int) float64 {
+	return p.K * float64(m) * float64(n) * math.Exp(-p.Lambda*float64(s))
+}
+
+// PValue returns the probability of finding at least one local alignment with a score
+// of at least s between a query of length m and a database of total length n.
+func (p KarlinAltschul) PValue(s, m, n int) float64 {
+	return -math.Expm1(-p.EValue(s, m, n))
+}
+
+// EffectiveLengths returns the query and database lengths corrected for the edge effect
+// of alignments being unable to extend beyond the ends of sequences, for a query of length
+// m and a database of total length n made up of seqs sequences. The correction is the
+// expected length of a high scoring alignment, ln(K×m×n)/H, removed from each sequence.
+// If H is not positive m and n are returned unaltered.
+func (p KarlinAltschul) EffectiveLengths(m, n, seqs int) (effM, effN int) {
+	if p.H <= 0 {
+		return m, n
+	}
+	if seqs < 1 {
+		seqs = 1
+	}
+	var l float64
+	for i := 0; i < 20; i++ {
+		em := math.Max(float64(m)-l, 1)
+		en := math.Max(float64(n)-float64(seqs)*l, 1)
+		next := math.Max(math.Log(p.K*em*en)/p.H, 0)
+		if math.Abs(next-l) < 0.5 {
+			l = next
+			break
+		}
+		l = next
+	}
+	return int(math.Max(float64(m)-l, 1)), int(math.Max(float64(n)-float64(seqs)*l, 1))
+}
+
+// A Composition is a background letter frequency distribution indexed by alphabet
+// letter index.
+type Composition []float64
+
+// NewComposition returns the Composition of alpha described by the letter frequencies
+// in f, normalised to sum to one. The gap letter and letters not in f are given a zero
+// frequency. Letters in f that are not valid in alpha or have negative frequencies
+// result in an error.
+func NewComposition(alpha alphabet.Alphabet, f map[alphabet.Letter]float64) (Composition, error) {
+	c := make(Composition, alpha.Len())
+	var sum float64
+	for l, v := range f {
+		i := alpha.IndexOf(l)
+		if i < 0 {
+			return nil, fmt.Errorf("align: illegal letter %q in composition", l)
+		}
+		if v < 0 {
+			return nil, fmt.Errorf("align: negative frequency for %q in composition", l)
+		}
+		if l == alpha.Gap() {
+			continue
+		}
+		c[i] += v
+		sum += v
+	}
+	if sum == 0 {
+		return nil, errors.New("align: empty composition")
+	}
+	for i := range c {
+		c[i] /= sum
+	}
+	return c, nil
+}
+
+// RobinsonRobinson is the amino acid background frequency distribution of Robinson and
+// Robinson (1991) used by BLAST.
+var RobinsonRobinson = map[alphabet.Letter]float64{
+	'A': 0.07805, 'C': 0.01925, 'D': 0.05364, 'E': 0.06295, 'F': 0.03856,
+	'G': 0.07377, 'H': 0.02199, 'I': 0.05142, 'K': 0.05744, 'L': 0.09019,
+	'M': 0.02243, 'N': 0.04487, 'P': 0.05203, 'Q': 0.04264, 'R': 0.05129,
+	'S': 0.07120, 'T': 0.05841, 'V': 0.06441, 'W': 0.01330, 'Y': 0.03216,
+}
+
+// UngappedParams returns the Karlin-Altschul parameters of ungapped local alignments
+// scored with the substitution scores of m between reference letters drawn from r and
+// query letters drawn from q. The gap penalties of m are not used. UngappedParams returns
+// an error if the expected score is not negative or no positive score is possible.
+func UngappedParams(m Linear, r, q Composition) (KarlinAltschul, error) {
+	if len(r) != len(m) || len(q) != len(m) {
+		return KarlinAltschul{}, fmt.Errorf("align: composition lengths %d and %d do not match matrix size %d", len(r), len(q), len(m))
+	}
+	for _, row := range m {
+		if len(row) != len(m) {
+			return KarlinAltschul{}, ErrMatrixNotSquare
+		}
+	}
+
+	// Find the score distribution.
+	low, high := 0, 0
+	for i := 1; i < len(m); i++ {
+		for j := 1; j < len(m); j++ {
+			if r[i] == 0 || q[j] == 0 {
+				continue
+			}
+			low = min2(low, m[i][j])
+			high = max2(high, m[i][j])
+		}
+	}
+	if high <= 0 {
+		return KarlinAltschul{}, ErrNoPositiveScore
+	}
+	p := make([]float64, high-low+1)
+	var mean, sum float64
+	for i := 1; i < len(m); i++ {
+		for j := 1; j < len(m); j++ {
+			if r[i] == 0 || q[j] == 0 {
+				continue
+			}
+			p[m[i][j]-low] += r[i] * q[j]
+			sum += r[i] * q[j]
+		}
+	}
+	for s := range p {
+		p[s] /= sum
+		mean += float64(s+low) * p[s]
+	}
+	if mean >= 0 {
+		return KarlinAltschul{}, ErrPositiveExpectedScore
+	}
+
+	lambda := karlinLambda(p, low)
+	h := karlinH(p, low, lambda)
+	return KarlinAltschul{Lambda: lambda, K: karlinK(p, low, lambda, h), H: h}, nil
+}
+
+// karlinLambda returns the unique positive root of Σ p(s)×exp(λs) = 1 where p(s) is held
+// in p offset by low.
+func karlinLambda(p []float64, low int) float64 {
+	f := func(lambda float64) float64 {
+		var sum float64
+		for s, v := range p {
+			sum += v * math.Exp(lambda*float64(s+low))
+		}
+		return sum - 1
+	}
+	lo, hi := 0.0, 0.5
+	for f(hi) < 0 {
+		lo, hi = hi, 2*hi
+	}
+	for i := 0; i < 100 && hi-lo > 1e-12; i++ {
+		mid := (lo + hi) / 2
+		if f(mid) < 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// karlinH returns the relative entropy of the score distribution p offset by low.
+func karlinH(p []float64, low int, lambda float64) float64 {
+	var h float64
+	for s, v := range p {
+		h += float64(s+low) * v * math.Exp(lambda*float64(s+low))
+	}
+	return lambda * h
+}
+
+// karlinK returns the K parameter of the score distribution p offset by low using the
+// series given by Karlin and Altschul (1990).
+func karlinK(p []float64, low int, lambda, h float64) float64 {
+	const (
+		maxIter  = 100
+		sumLimit = 1e-10
+	)
+
+	// Find the greatest common divisor of the possible scores.
+	var d int
+	for s, v := range p {
+		if v != 0 {
+			d = gcd(d, s+low)
+		}
+	}
+
+	// σ = Σ_{k≥1} 1/k × (Σ_{j<0} P(S_k=j)×exp(λj) + Σ_{j≥0} P(S_k=j))
+	// where S_k is the sum of k scores.
+	var sigma float64
+	pk := []float64{1}
+	lowK := 0
+	for k := 1; k <= maxIter; k++ {
+		next := make([]float64, len(pk)+len(p)-1)
+		for i, a := range pk {
+			if a == 0 {
+				continue
+			}
+			for j, b := range p {
+				next[i+j] += a * b
+			}
+		}
+		pk = next
+		lowK += low
+
+		var inner float64
+		for i, v := range pk {
+			if j := i + lowK; j < 0 {
+				inner += v * math.Exp(lambda*float64(j))
+			} else {
+				inner += v
+			}
+		}
+		inner /= float64(k)
+		sigma += inner
+		if inner < sumLimit {
+			break
+		}
+	}
+
+	ld := lambda * float64(d)
+	return ld * math.Exp(-2*sigma) / (h * -math.Expm1(-ld))
+}
+
+func gcd(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// EstimateParams returns estimates of the Karlin-Altschul λ and K parameters for the
+// local aligner a. The estimates are obtained by fitting an extreme value distribution
+// by the method of moments to the optimal alignment scores of n pairs of random sequences
+// of the given length with reference letters drawn from r and query letters drawn from q
+// using rnd as the source of randomness, or the global source if rnd is nil. The H field
+// of the returned parameters is not estimated and is zero.
+//
+// EstimateParams allows parameters to be obtained for gapped scoring systems for which
+// published parameters are not available. Estimates are more accurate with longer
+// sequences and larger numbers of samples.
+func EstimateParams(a Aligner, alpha alphabet.Alphabet, r, q Composition, length, n int, rnd *rand.Rand) (KarlinAltschul, error) {
+	if len(r) != alpha.Len() || len(q) != alpha.Len() {
+		return KarlinAltschul{}, fmt.Errorf("align: composition lengths %d and %d do not match alphabet length %d", len(r), len(q), alpha.Len())
+	}
+	if length < 1 || n < 2 {
+		return KarlinAltschul{}, errors.New("align: too few samples for estimation")
+	}
+	rSample, err := newSampler(alpha, r, rnd)
+	if err != nil {
+		return KarlinAltschul{}, err
+	}
+	qSample, err := newSampler(alpha, q, rnd)
+	if err != nil {
+		return KarlinAltschul{}, err
+	}
+
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		pairs, err := a.Align(rSample.letters(length), qSample.letters(length))
+		if err != nil {
+			return KarlinAltschul{}, err
+		}
+		s := float64(pairsScore(pairs))
+		sum += s
+		sumSq += s * s
+	}
+	mean := sum / float64(n)
+	variance := (sumSq - sum*mean) / float64(n-1)
+	if variance <= 0 {
+		return KarlinAltschul{}, errors.New("align: no variation in sampled scores")
+	}
+
+	const eulerGamma = 0.5772156649015329
+	lambda := math.Pi / math.Sqrt(6*variance)
+	mu := mean - eulerGamma/lambda
+	return KarlinAltschul{
+		Lambda: lambda,
+		K:      math.Exp(lambda*mu) / (float64(length) * float64(length)),
+	}, nil
+}
+
+// pairsScore returns the total score of the feature pairs in an alignment.
+func pairsScore(pairs []feat.Pair) int {
+	var s int
+	for _, fp := range pairs {
+		if sc, ok := fp.(interface{ Score() int }); ok {
+			s += sc.Score()
+		}
+	}
+	return s
+}
+
+// sampler generates random letter sequences with a given composition.
+type sampler struct {
+	alpha alphabet.Alphabet
+	index []int
+	cdf   []float64
+	rnd   *rand.Rand
+}
+
+func newSampler(alpha alphabet.Alphabet, c Composition, rnd *rand.Rand) (*sampler, error) {
+	s := &sampler{alpha: alpha, rnd: rnd}
+	var sum float64
+	for i, v := range c {
+		if v < 0 {
+			return nil, fmt.Errorf("align: negative frequency for %q in composition", alpha.Letter(i))
+		}
+		if v == 0 {
+			continue
+		}
+		sum += v
+		s.index = append(s.index, i)
+		s.cdf = append(s.cdf, sum)
+	}
+	if sum == 0 {
+		return nil, errors.New("align: empty composition")
+	}
+	for i := range s.cdf {
+		s.cdf[i] /= sum
+	}
+	return s, nil
+}
+
+func (s *sampler) letters(n int) letterSlicer {
+	l := make(alphabet.Letters, n)
+	for i := range l {
+		var u float64
+		if s.rnd == nil {
+			u = rand.Float64()
+		} else {
+			u = s.rnd.Float64()
+		}
+		k := sort.SearchFloat64s(s.cdf, u)
+		if k == len(s.cdf) {
+			k--
+		}
+		l[i] = s.alpha.Letter(s.index[k])
+	}
+	return letterSlicer{alpha: s.alpha, s: l}
+}
+
+// letterSlicer is a minimal AlphabetSlicer.
+type letterSlicer struct {
+	alpha alphabet.Alphabet
+	s     alphabet.Letters
+}
+
+func (l letterSlicer) Alphabet() alphabet.Alphabet { return l.alpha }
+func (l letterSlicer) Slice() alphabet.Slice       { return l.s }
+
+type gapCosts struct {
+	open, extend int
+}
+
+// GappedParams returns the published BLAST Karlin-Altschul parameters for gapped local
+// alignment using the scoring matrix m with the given gap costs. The gap costs are
+// expressed as used by the Affine aligners, so a gap of length k is scored open + k×extend;
+// BLAST gap costs of 11/1 correspond to an open of -11 and an extend of -1.
+//
+// The scores of m are compared with the align/matrix tables BLOSUM45, BLOSUM50, BLOSUM62,
+// BLOSUM90, PAM30, PAM70 and PAM250 to identify the matrix; the gap row and column, index 0,
+// are ignored so m may hold its own gap scores. Parameters are available for a subset of gap
+// costs for each of these matrices. GappedParams returns an error if m is not one of these
+// matrices or no parameters are available for the gap costs.
+func GappedParams(m Linear, open, extend int) (KarlinAltschul, error) {
+	name := matrixName(m)
+	if name == "" {
+		return KarlinAltschul{}, errors.New("align: no gapped parameters for scoring matrix")
+	}
+	p, ok := gappedParams[name][gapCosts{open: open, extend: extend}]
+	if !ok {
+		return KarlinAltschul{}, fmt.Errorf("align: no gapped parameters for %s with gap costs %d/%d", name, open, extend)
+	}
+	return p, nil
+}
+
+// gappedMatrices holds the scoring matrices with gapped parameters in gappedParams.
+var gappedMatrices = []struct {
+	name   string
+	matrix [][]int
+}{
+	{"BLOSUM45", matrix.BLOSUM45},
+	{"BLOSUM50", matrix.BLOSUM50},
+	{"BLOSUM62", matrix.BLOSUM62},
+	{"BLOSUM90", matrix.BLOSUM90},
+	{"PAM30", matrix.PAM30},
+	{"PAM70", matrix.PAM70},
+	{"PAM250", matrix.PAM250},
+}
+
+// matrixName returns the name of the matrix in gappedMatrices with the same letter
+// scores as m, or the empty string if there is none.
+func matrixName(m Linear) string {
+outer:
+	for _, k := range gappedMatrices {
+		if len(m) != len(k.matrix) {
+			continue
+		}
+		for i := 1; i < len(m); i++ {
+			if len(m[i]) != len(k.matrix[i]) {
+				continue outer
+			}
+			for j := 1; j < len(m[i]); j++ {
+				if m[i][j] != k.matrix[i][j] {
+					continue outer
+				}
+			}
+		}
+		return k.name
+	}
+	return ""
+}
+
+// gappedParams holds the gapped Karlin-Altschul parameters used by NCBI BLAST.
+var gappedParams = map[string]map[gapCosts]KarlinAltschul{
+	"BLOSUM45": {
+		{-13, -3}: {0.207, 0.049, 0.14},
+		{-12, -3}: {0.199, 0.039, 0.11},
+		{-11, -3}: {0.190, 0.031, 0.095},
+		{-10, -3}: {0.179, 0.023, 0.075},
+		{-16, -2}: {0.210, 0.051, 0.14},
+		{-15, -2}: {0.203, 0.041, 0.12},
+		{-14, -2}: {0.195, 0.032, 0.10},
+		{-13, -2}: {0.185, 0.024, 0.084},
+		{-12, -2}: {0.171, 0.016, 0.061},
+		{-19, -1}: {0.205, 0.040, 0.11},
+		{-18, -1}: {0.198, 0.032, 0.10},
+		{-17, -1}: {0.189, 0.024, 0.079},
+		{-16, -1}: {0.176, 0.016, 0.063},
+		{-15, -1}: {0.159, 0.010, 0.050},
+	},
+	"BLOSUM50": {
+		{-13, -3}: {0.212, 0.063, 0.19},
+		{-12, -3}: {0.206, 0.055, 0.17},
+		{-11, -3}: {0.197, 0.042, 0.14},
+		{-10, -3}: {0.186, 0.031, 0.11},
+		{-9, -3}:  {0.172, 0.022, 0.082},
+		{-16, -2}: {0.215, 0.066, 0.20},
+		{-15, -2}: {0.210, 0.058, 0.17},
+		{-14, -2}: {0.202, 0.045, 0.14},
+		{-13, -2}: {0.193, 0.035, 0.12},
+		{-12, -2}: {0.181, 0.025, 0.095},
+		{-19, -1}: {0.212, 0.057, 0.18},
+		{-18, -1}: {0.207, 0.050, 0.15},
+		{-17, -1}: {0.198, 0.037, 0.12},
+		{-16, -1}: {0.186, 0.025, 0.10},
+		{-15, -1}: {0.171, 0.015, 0.063},
+	},
+	"BLOSUM62": {
+		{-11, -2}: {0.297, 0.082, 0.27},
+		{-10, -2}: {0.291, 0.075, 0.23},
+		{-9, -2}:  {0.279, 0.058, 0.19},
+		{-8, -2}:  {0.264, 0.045, 0.15},
+		{-7, -2}:  {0.239, 0.027, 0.10},
+		{-6, -2}:  {0.201, 0.012, 0.061},
+		{-13, -1}: {0.292, 0.071, 0.23},
+		{-12, -1}: {0.283, 0.059, 0.19},
+		{-11, -1}: {0.267, 0.041, 0.14},
+		{-10, -1}: {0.243, 0.024, 0.10},
+		{-9, -1}:  {0.206, 0.010, 0.052},
+	},
+	"BLOSUM90": {
+		{-9, -2}:  {0.310, 0.12, 0.46},
+		{-8, -2}:  {0.300, 0.099, 0.39},
+		{-7, -2}:  {0.283, 0.072, 0.30},
+		{-6, -2}:  {0.259, 0.048, 0.22},
+		{-11, -1}: {0.302, 0.093, 0.39},
+		{-10, -1}: {0.290, 0.075, 0.28},
+		{-9, -1}:  {0.265, 0.044, 0.20},
+	},
+	"PAM30": {
+		{-7, -2}:  {0.305, 0.15, 0.87},
+		{-6, -2}:  {0.287, 0.11, 0.68},
+		{-5, -2}:  {0.264, 0.079, 0.45},
+		{-10, -1}: {0.309, 0.15, 0.88},
+		{-9, -1}:  {0.294, 0.11, 0.61},
+		{-8, -1}:  {0.270, 0.072, 0.40},
+	},
+	"PAM70": {
+		{-8, -2}:  {0.301, 0.12, 0.54},
+		{-7, -2}:  {0.286, 0.093, 0.43},
+		{-6, -2}:  {0.264, 0.064, 0.29},
+		{-11, -1}: {0.305, 0.12, 0.52},
+		{-10, -1}: {0.291, 0.091, 0.41},
+		{-9, -1}:  {0.270, 0.060, 0.28},
+	},
+	"PAM250": {
+		{-15, -3}: {0.205, 0.049, 0.13},
+		{-14, -3}: {0.200, 0.043, 0.12},
+		{-13, -3}: {0.194, 0.036, 0.10},
+		{-12, -3}: {0.186, 0.029, 0.085},
+		{-11, -3}: {0.174, 0.020, 0.070},
+		{-17, -2}: {0.204, 0.047, 0.12},
+		{-16, -2}: {0.198, 0.040, 0.11},
+		{-15, -2}: {0.191, 0.032, 0.10},
+		{-14, -2}: {0.182, 0.025, 0.078},
+		{-13, -2}: {0.171, 0.017, 0.061},
+		{-21, -1}: {0.205, 0.045, 0.11},
+		{-20, -1}: {0.199, 0.038, 0.10},
+		{-19, -1}: {0.192, 0.031, 0.088},
+		{-18, -1}: {0.183, 0.024, 0.072},
+		{-17, -1}: {0.171, 0.016, 0.056},
+	},
+}
@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleGappedParams() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("MKVLAAGIVGLLLAQPAMAHEAWQRLGEKLVNALSKGDLHEAAKL")), alphabet.Protein)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("MKVLSAGIVALLLAQPAMAHDAWQRLGEKLVNALSKG")), alphabet.Protein)
+
+	// BLOSUM62 with BLAST gap costs of 11/1.
+	m := make(Linear, len(matrix.BLOSUM62))
+	for i, row := range matrix.BLOSUM62 {
+		m[i] = append([]int(nil), row...)
+		m[i][0], m[0][i] = -1, -1
+	}
+	m[0][0] = 0
+	sw := SWAffine{Matrix: m, GapOpen: -11}
+
+	score, err := sw.Score(ref, query)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	p, err := GappedParams(m, -11, -1)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("score: %d bits: %.1f E-value (10⁶ residue database): %.2g\n",
+		score, p.BitScore(score), p.EValue(score, query.Len(), 1e6))
+	// Output:
+	// score: 170 bits: 70.1 E-value (10⁶ residue database): 2.9e-14
+}
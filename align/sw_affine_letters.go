@@ -82,7 +82,7 @@ func pointerSWAffineLetters(rSeq, qSeq alphabet.Letters, i, j, l int, table [][3
 	}
 }
 
-func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
@@ -95,7 +95,7 @@ func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alpha
 		la = append(la, row...)
 	}
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 
 	var (
 		index = alpha.LetterIndex()
@@ -0,0 +1,336 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// Score returns the score of the best local alignment of two sequences using the Smith-Waterman
+// algorithm. No traceback is performed and only O(m) space is used. Score returns an error if the
+// scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a SWAffine) Score(reference, query AlphabetSlicer) (int, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return 0, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return 0, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return 0, ErrNotGappedAlphabet
+	}
+	la, let, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return 0, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return 0, err
+	}
+
+	c := len(qSeq) + 1
+	row := make([][3]int, c)
+	var maxS int
+	for _, rVal := range rSeq {
+		var diagScores [3]int
+		for j := 1; j < c; j++ {
+			qVal := qSeq[j-1]
+			var score [3]int
+
+			s := max3(diagScores[diag], diagScores[up], diagScores[left])
+			matched := s == diagScores[diag]
+			s += la[rVal*let+qVal]
+			if s > 0 {
+				if s > maxS && matched {
+					maxS = s
+				}
+				score[diag] = s
+			}
+			score[up] = max2(max2(
+				row[j][diag]+a.GapOpen+la[rVal*let],
+				row[j][up]+la[rVal*let],
+			), 0)
+			score[left] = max2(max2(
+				row[j-1][diag]+a.GapOpen+la[qVal],
+				row[j-1][left]+la[qVal],
+			), 0)
+
+			diagScores, row[j] = row[j], score
+		}
+	}
+
+	return maxS, nil
+}
+
+// Suboptimal returns up to k non-overlapping local alignments of two sequences using the
+// Waterman-Eggert extension of the Smith-Waterman algorithm. Each hit is the best alignment
+// remaining after the cells of the dynamic programming table on the paths of earlier hits
+// have been excluded, so no two hits align the same pair of positions. Hits are returned in
+// the order they are found; the first hit is the alignment returned by Align. Suboptimal
+// returns an error if the scoring matrix is not square, or the sequence data types or
+// alphabets do not match.
+func (a SWAffine) Suboptimal(reference, query AlphabetSlicer, k int) ([]Hit, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	la, let, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	r, c := len(rSeq)+1, len(qSeq)+1
+	w := watermanAffine{
+		la: la, let: let, open: a.GapOpen,
+		rSeq: rSeq, qSeq: qSeq,
+		table: make([][3]int, r*c),
+		mask:  make([]bool, r*c),
+	}
+	w.fill(1, 1, r)
+
+	var hits []Hit
+	for len(hits) < k {
+		maxS, maxI, maxJ := w.best()
+		if maxS <= 0 {
+			break
+		}
+		aln, i0, j0 := w.traceback(maxI, maxJ)
+		hits = append(hits, Hit{Pairs: aln, Score: maxS})
+		w.fill(i0, j0, maxI)
+	}
+
+	return hits, nil
+}
+
+// watermanAffine holds the state for an affine gap penalty Waterman-Eggert alignment.
+type watermanAffine struct {
+	la   []int
+	let  int
+	open int
+
+	rSeq, qSeq []int
+
+	table [][3]int
+
+	// mask holds the cells of the table that
+	// are on the path of an earlier hit.
+	mask []bool
+}
+
+// fill calculates the table for the cells at or below row i0 and at or to the
+// right of column j0. Filling stops at the first unchanged row below row last.
+func (w *watermanAffine) fill(i0, j0, last int) {
+	la, let := w.la, w.let
+	table := w.table
+	r, c := len(w.rSeq)+1, len(w.qSeq)+1
+	for i := i0; i < r; i++ {
+		var changed bool
+		rVal := w.rSeq[i-1]
+		for j := j0; j < c; j++ {
+			p := i*c + j
+			var score [3]int
+			if !w.mask[p] {
+				qVal := w.qSeq[j-1]
+				score = [3]int{
+					diag: max2(max3(table[p-c-1][diag], table[p-c-1][up], table[p-c-1][left])+la[rVal*let+qVal], 0),
+					up: max2(max2(
+						table[p-c][diag]+w.open+la[rVal*let],
+						table[p-c][up]+la[rVal*let],
+					), 0),
+					left: max2(max2(
+						table[p-1][diag]+w.open+la[qVal],
+						table[p-1][left]+la[qVal],
+					), 0),
+				}
+			}
+			if table[p] != score {
+				table[p] = score
+				changed = true
+			}
+		}
+		if !changed && i > last {
+			break
+		}
+	}
+}
+
+// best returns the highest scoring cell reached by a match in the same way as SWAffine.
+func (w *watermanAffine) best() (maxS, maxI, maxJ int) {
+	la, let := w.la, w.let
+	table := w.table
+	r, c := len(w.rSeq)+1, len(w.qSeq)+1
+	for i := 1; i < r; i++ {
+		rVal := w.rSeq[i-1]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			score := table[p][diag]
+			if score <= 0 || score < maxS {
+				continue
+			}
+			prev := table[p-c-1]
+			s := max3(prev[diag], prev[up], prev[left])
+			if s == prev[diag] && score == s+la[rVal*let+w.qSeq[j-1]] {
+				maxS, maxI, maxJ = score, i, j
+			}
+		}
+	}
+	return maxS, maxI, maxJ
+}
+
+// traceback returns the alignment ending at (maxI, maxJ) and masks the cells on its
+// path. It returns the lowest row and column of the path.
+func (w *watermanAffine) traceback(maxI, maxJ int) (aln []feat.Pair, minI, minJ int) {
+	la, let := w.la, w.let
+	table := w.table
+	c := len(w.qSeq) + 1
+
+	score, last, layer := 0, diag, diag
+	i, j := maxI, maxJ
+	minI, minJ = i, j
+loop:
+	for i > 0 && j > 0 {
+		var (
+			rVal = w.rSeq[i-1]
+			qVal = w.qSeq[j-1]
+		)
+		p := i*c + j
+		if table[p][layer] != 0 {
+			w.mask[p] = true
+			minI, minJ = i, j
+		}
+		switch table[p][layer] {
+		case 0:
+			break loop
+		case table[p-c][up] + la[rVal*let]:
+			if last != up && p != len(table)-1 {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-c][up]
+			i--
+			layer = up
+			last = up
+		case table[p-1][left] + la[qVal]:
+			if last != left && p != len(table)-1 {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-1][left]
+			j--
+			layer = left
+			last = left
+		case table[p-c][diag] + w.open + la[rVal*let]:
+			if last != up && p != len(table)-1 {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-c][diag]
+			i--
+			layer = diag
+			last = up
+		case table[p-1][diag] + w.open + la[qVal]:
+			if last != left && p != len(table)-1 {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-1][diag]
+			j--
+			layer = diag
+			last = left
+		case table[p-c-1][diag] + la[rVal*let+qVal]:
+			if last != diag {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-c-1][diag]
+			i--
+			j--
+			layer = diag
+			last = diag
+		case table[p-c-1][up] + la[rVal*let+qVal]:
+			if last != diag {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-c-1][up]
+			i--
+			j--
+			layer = up
+			last = diag
+		case table[p-c-1][left] + la[rVal*let+qVal]:
+			if last != diag {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p][layer] - table[p-c-1][left]
+			i--
+			j--
+			layer = left
+			last = diag
+		default:
+			panic(fmt.Sprintf("align: waterman affine internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+		}
+	}
+
+	aln = append(aln, &featPair{
+		a:     feature{start: i, end: maxI},
+		b:     feature{start: j, end: maxJ},
+		score: score,
+	})
+
+	for i, j := 0, len(aln)-1; i < j; i, j = i+1, j-1 {
+		aln[i], aln[j] = aln[j], aln[i]
+	}
+
+	return aln, minI, minJ
+}
@@ -106,3 +106,109 @@ func ExampleSWAffine_Align() {
 	// ATAGGAA
 	// ATTGGCA
 }
+
+func ExampleSWStriped_Align() {
+	swsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATAGGAAG"))}
+	swsa.Alpha = alphabet.DNAgapped
+	swsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ATTGGCAATG"))}
+	swsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-1	-1	-1	-1
+	// A	-1	 1	-1	-1	-1
+	// C	-1	-1	 1	-1	-1
+	// G	-1	-1	-1	 1	-1
+	// T	-1	-1	-1	-1	 1
+	//
+	// Gap open: -5
+	smith := SWStriped{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 1, -1, -1, -1},
+			{-1, -1, 1, -1, -1},
+			{-1, -1, -1, 1, -1},
+			{-1, -1, -1, -1, 1},
+		},
+		GapOpen: -5,
+	}
+
+	aln, err := smith.Align(swsa, swsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(swsa, swsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,7)/[0,7)=3]
+	// ATAGGAA
+	// ATTGGCA
+}
+
+func ExampleSWBanded_Align() {
+	swsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACACACTA"))}
+	swsa.Alpha = alphabet.DNAgapped
+	swsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGCACACA"))}
+	swsb.Alpha = alphabet.DNAgapped
+
+	// w(gap) = -1
+	// w(match) = +2
+	// w(mismatch) = -1
+	//
+	// Only consider cells within one diagonal of the main diagonal.
+	smith := SWBanded{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 2, -1, -1, -1},
+			{-1, -1, 2, -1, -1},
+			{-1, -1, -1, 2, -1},
+			{-1, -1, -1, -1, 2},
+		},
+		Width: 1,
+	}
+
+	aln, err := smith.Align(swsa, swsb)
+	if err == nil {
+		fmt.Printf("%v\n", aln)
+		fa := Format(swsa, swsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[0,1)/[0,1)=2 -/[1,2)=-1 [1,6)/[2,7)=10 [6,7)/-=-1 [7,8)/[7,8)=2]
+	// A-CACACTA
+	// AGCACAC-A
+}
+
+func ExampleSW_Suboptimal() {
+	swsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GATTACAccccGATTAGA"))}
+	swsa.Alpha = alphabet.DNAgapped
+	swsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ttGATTACAtt"))}
+	swsb.Alpha = alphabet.DNAgapped
+
+	// w(gap) = -2
+	// w(match) = +2
+	// w(mismatch) = -1
+	smith := SW{
+		{0, -2, -2, -2, -2},
+		{-2, 2, -1, -1, -1},
+		{-2, -1, 2, -1, -1},
+		{-2, -1, -1, 2, -1},
+		{-2, -1, -1, -1, 2},
+	}
+
+	hits, err := smith.Suboptimal(swsa, swsb, 2)
+	if err == nil {
+		for _, h := range hits {
+			fmt.Printf("%d %v\n", h.Score, h.Pairs)
+			fa := Format(swsa, swsb, h.Pairs, '-')
+			fmt.Printf("%s\n%s\n", fa[0], fa[1])
+		}
+	}
+	// Output:
+	// 14 [[0,7)/[2,9)=14]
+	// GATTACA
+	// GATTACA
+	// 11 [[11,18)/[2,9)=11]
+	// GATTAGA
+	// GATTACA
+}
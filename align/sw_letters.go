@@ -65,7 +65,7 @@ func pointerSWLetters(rSeq, qSeq alphabet.Letters, i, j int, table []int, index
 	}
 }
 
-func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, buf *tables) ([]feat.Pair, error) {
 	let := len(a)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
@@ -78,7 +78,7 @@ func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) (
 		la = append(la, row...)
 	}
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([]int, r*c)
+	table := buf.linear(r * c)
 
 	var (
 		index = alpha.LetterIndex()
@@ -0,0 +1,265 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+)
+
+// A Hit is a local alignment description and its score.
+type Hit struct {
+	Pairs []feat.Pair
+	Score int
+}
+
+// Score returns the score of the best local alignment of two sequences using the Smith-Waterman
+// algorithm. No traceback is performed and only O(m) space is used. Score returns an error if the
+// scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a SW) Score(reference, query AlphabetSlicer) (int, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return 0, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return 0, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return 0, ErrNotGappedAlphabet
+	}
+	la, let, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return 0, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return 0, err
+	}
+
+	c := len(qSeq) + 1
+	row := make([]int, c)
+	var maxS int
+	for _, rVal := range rSeq {
+		diagScore := 0
+		for j := 1; j < c; j++ {
+			qVal := qSeq[j-1]
+			match := diagScore + la[rVal*let+qVal]
+			score := max3(
+				match,
+				row[j]+la[rVal*let],
+				row[j-1]+la[qVal],
+			)
+			if score > 0 {
+				if score > maxS && score == match {
+					maxS = score
+				}
+			} else {
+				score = 0
+			}
+			diagScore, row[j] = row[j], score
+		}
+	}
+
+	return maxS, nil
+}
+
+// Suboptimal returns up to k non-overlapping local alignments of two sequences using the
+// Waterman-Eggert extension of the Smith-Waterman algorithm. Each hit is the best alignment
+// remaining after the cells of the dynamic programming table on the paths of earlier hits
+// have been excluded, so no two hits align the same pair of positions. Hits are returned in
+// the order they are found; the first hit is the alignment returned by Align. Suboptimal
+// returns an error if the scoring matrix is not square, or the sequence data types or
+// alphabets do not match.
+func (a SW) Suboptimal(reference, query AlphabetSlicer, k int) ([]Hit, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	la, let, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return nil, err
+	}
+	rSeq, qSeq, err := indexPair(reference.Slice(), query.Slice(), alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	r, c := len(rSeq)+1, len(qSeq)+1
+	w := waterman{
+		la: la, let: let,
+		rSeq: rSeq, qSeq: qSeq,
+		table: make([]int, r*c),
+		mask:  make([]bool, r*c),
+	}
+	w.fill(1, 1, r)
+
+	var hits []Hit
+	for len(hits) < k {
+		maxS, maxI, maxJ := w.best()
+		if maxS <= 0 {
+			break
+		}
+		aln, i0, j0 := w.traceback(maxI, maxJ)
+		hits = append(hits, Hit{Pairs: aln, Score: maxS})
+		w.fill(i0, j0, maxI)
+	}
+
+	return hits, nil
+}
+
+// waterman holds the state for a linear gap penalty Waterman-Eggert alignment.
+type waterman struct {
+	la  []int
+	let int
+
+	rSeq, qSeq []int
+
+	table []int
+
+	// mask holds the cells of the table that
+	// are on the path of an earlier hit.
+	mask []bool
+}
+
+// fill calculates the table for the cells at or below row i0 and at or to the
+// right of column j0. Filling stops at the first unchanged row below row last.
+func (w *waterman) fill(i0, j0, last int) {
+	la, let := w.la, w.let
+	table := w.table
+	r, c := len(w.rSeq)+1, len(w.qSeq)+1
+	for i := i0; i < r; i++ {
+		var changed bool
+		rVal := w.rSeq[i-1]
+		for j := j0; j < c; j++ {
+			p := i*c + j
+			var score int
+			if !w.mask[p] {
+				qVal := w.qSeq[j-1]
+				score = max3(
+					table[p-c-1]+la[rVal*let+qVal],
+					table[p-c]+la[rVal*let],
+					table[p-1]+la[qVal],
+				)
+				if score < 0 {
+					score = 0
+				}
+			}
+			if table[p] != score {
+				table[p] = score
+				changed = true
+			}
+		}
+		if !changed && i > last {
+			break
+		}
+	}
+}
+
+// best returns the highest scoring cell reached by a match in the same way as SW.
+func (w *waterman) best() (maxS, maxI, maxJ int) {
+	la, let := w.la, w.let
+	table := w.table
+	r, c := len(w.rSeq)+1, len(w.qSeq)+1
+	for i := 1; i < r; i++ {
+		rVal := w.rSeq[i-1]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			score := table[p]
+			if score > 0 && score >= maxS && score == table[p-c-1]+la[rVal*let+w.qSeq[j-1]] {
+				maxS, maxI, maxJ = score, i, j
+			}
+		}
+	}
+	return maxS, maxI, maxJ
+}
+
+// traceback returns the alignment ending at (maxI, maxJ) and masks the cells on its
+// path. It returns the lowest row and column of the path.
+func (w *waterman) traceback(maxI, maxJ int) (aln []feat.Pair, minI, minJ int) {
+	la, let := w.la, w.let
+	table := w.table
+	c := len(w.qSeq) + 1
+
+	score, last := 0, diag
+	i, j := maxI, maxJ
+	minI, minJ = i, j
+loop:
+	for i > 0 && j > 0 {
+		var (
+			rVal = w.rSeq[i-1]
+			qVal = w.qSeq[j-1]
+		)
+		p := i*c + j
+		if table[p] != 0 {
+			w.mask[p] = true
+			minI, minJ = i, j
+		}
+		switch table[p] {
+		case 0:
+			break loop
+		case table[p-c-1] + la[rVal*let+qVal]:
+			if last != diag {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p] - table[p-c-1]
+			i--
+			j--
+			last = diag
+		case table[p-c] + la[rVal*let]:
+			if last != up {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p] - table[p-c]
+			i--
+			last = up
+		case table[p-1] + la[qVal]:
+			if last != left {
+				aln = append(aln, &featPair{
+					a:     feature{start: i, end: maxI},
+					b:     feature{start: j, end: maxJ},
+					score: score,
+				})
+				maxI, maxJ = i, j
+				score = 0
+			}
+			score += table[p] - table[p-1]
+			j--
+			last = left
+		default:
+			panic(fmt.Sprintf("align: waterman internal error: no path at row: %d col:%d\n", i, j))
+		}
+	}
+
+	aln = append(aln, &featPair{
+		a:     feature{start: i, end: maxI},
+		b:     feature{start: j, end: maxJ},
+		score: score,
+	})
+
+	for i, j := 0, len(aln)-1; i < j; i, j = i+1, j-1 {
+		aln[i], aln[j] = aln[j], aln[i]
+	}
+
+	return aln, minI, minJ
+}
@@ -0,0 +1,315 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotNucleic = errors.New("align: reference is not a nucleic acid sequence")
+	ErrNotProtein = errors.New("align: query is not a protein sequence")
+)
+
+// Translated is the frameshift-aware protein to nucleic acid local aligner type. The
+// reference is a DNA or RNA sequence and the query is a protein sequence. Codons of
+// the reference are translated using GeneticCode, or the standard genetic code if
+// GeneticCode is nil, and scored against query residues using Matrix, a protein scoring
+// matrix such as those provided by the align/matrix package. Codons containing ambiguous
+// bases translate to X.
+//
+// The gap penalties in the first row and column of Matrix are not used. A gap of k
+// residues or reference codons is scored GapOpen + k×GapExtend, and a frameshift,
+// modelled as one or two reference bases between adjacent codons that are not
+// translated, is scored Frameshift.
+type Translated struct {
+	Matrix     Linear
+	GapOpen    int
+	GapExtend  int
+	Frameshift int
+
+	GeneticCode *alphabet.GeneticCode
+}
+
+// A Framer is a feature pair of a translated alignment that reports the reading frame
+// of its reference feature.
+type Framer interface {
+	feat.Pair
+
+	// Frame returns the reading frame of the reference
+	// feature, the start of the feature modulo 3.
+	Frame() int
+}
+
+type framePair struct {
+	featPair
+}
+
+func (fp *framePair) Frame() int { return fp.a.start % 3 }
+
+// Align aligns a protein query to a nucleic acid reference. It returns an alignment description
+// or an error if the scoring matrix is not square or is too small for the query alphabet, or the
+// sequence types are not nucleic acid and protein respectively. Reference features are in base
+// coordinates and query features are in residue coordinates. Each returned feat.Pair is a Framer.
+// Codon matches and reference gaps have lengths that are multiples of three and frameshifts are
+// described by pairs with a reference feature of one or two bases aligned to an empty query feature.
+func (a Translated) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	rAlpha, qAlpha := reference.Alphabet(), query.Alphabet()
+	if rAlpha == nil || qAlpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if m := rAlpha.Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, ErrNotNucleic
+	}
+	if qAlpha.Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	la, let, err := flatten(a.Matrix, qAlpha)
+	if err != nil {
+		return nil, err
+	}
+	var qSeq []int
+	switch q := query.Slice().(type) {
+	case alphabet.Letters:
+		qSeq, err = indicesOfLetters(q, qAlpha.LetterIndex(), "qSeq")
+	case alphabet.QLetters:
+		qSeq, err = indicesOfQLetters(q, qAlpha.LetterIndex(), "qSeq")
+	default:
+		err = ErrTypeNotHandled
+	}
+	if err != nil {
+		return nil, err
+	}
+	gc := a.GeneticCode
+	if gc == nil {
+		gc = alphabet.Standard
+	}
+	codons, err := translateFrames(reference.Slice(), rAlpha, qAlpha, gc)
+	if err != nil {
+		return nil, err
+	}
+
+	t := translated{
+		la: la, let: let,
+		open: a.GapOpen, extend: a.GapExtend, shift: a.Frameshift,
+		codons: codons, qSeq: qSeq,
+	}
+	return t.align(), nil
+}
+
+// translateFrames returns the query alphabet index of the translation by gc of the codon
+// starting at each position of the nucleic acid sequence s. Positions within two bases
+// of the end of s hold -1.
+func translateFrames(s alphabet.Slice, nucleic, protein alphabet.Alphabet, gc *alphabet.GeneticCode) ([]int, error) {
+	var letters []alphabet.Letter
+	switch s := s.(type) {
+	case alphabet.Letters:
+		letters = s
+	case alphabet.QLetters:
+		letters = make([]alphabet.Letter, len(s))
+		for i, l := range s {
+			letters[i] = l.L
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+
+	index := protein.LetterIndex()
+	x := index['x']
+	if x < 0 {
+		return nil, fmt.Errorf("align: protein alphabet has no %q", 'x')
+	}
+	for i, l := range letters {
+		if !nucleic.IsValid(l) {
+			return nil, fmt.Errorf("align: illegal letter %q at position %d in rSeq", l, i)
+		}
+	}
+
+	codons := make([]int, len(letters))
+	for i := range codons {
+		codons[i] = -1
+		if i+3 > len(letters) {
+			continue
+		}
+		aa := index[gc.Translate(alphabet.CodonOf(letters[i], letters[i+1], letters[i+2]))]
+		if aa < 0 {
+			aa = x
+		}
+		codons[i] = aa
+	}
+	return codons, nil
+}
+
+// Moves of a translated alignment path in addition to diag, which aligns
+// a codon to a residue, up, which skips a codon, and left, which skips a
+// residue.
+const (
+	shift1 = iota + left + 1
+	shift2
+)
+
+// translated holds the state for a protein to nucleic acid alignment.
+type translated struct {
+	la  []int
+	let int
+
+	open, extend, shift int
+
+	// codons holds the query alphabet index of the
+	// translation of the codon starting at each
+	// position of the reference.
+	codons []int
+	qSeq   []int
+}
+
+func (t *translated) align() []feat.Pair {
+	la, let := t.la, t.let
+	r, c := len(t.codons)+1, len(t.qSeq)+1
+	table := make([][3]int, r*c)
+
+	maxS, maxI, maxJ := 0, 0, 0
+	for i := 3; i < r; i++ {
+		aa := t.codons[i-3]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			s := la[aa*let+t.qSeq[j-1]]
+			best := 0
+			for k := 0; k < 3 && i-3-k >= 0; k++ {
+				prev := table[p-(3+k)*c-1]
+				v := max3(prev[diag], prev[up], prev[left]) + s
+				if k != 0 {
+					v += t.shift
+				}
+				best = max2(best, v)
+			}
+			table[p] = [3]int{
+				diag: best,
+				up: max2(max2(
+					table[p-3*c][diag]+t.open+t.extend,
+					table[p-3*c][up]+t.extend,
+				), 0),
+				left: max2(max2(
+					table[p-1][diag]+t.open+t.extend,
+					table[p-1][left]+t.extend,
+				), 0),
+			}
+			if best > 0 && best >= maxS {
+				maxS, maxI, maxJ = best, i, j
+			}
+		}
+	}
+
+	var moves []byte
+	i, j, layer := maxI, maxJ, diag
+	for i > 0 && j > 0 {
+		p := i*c + j
+		score := table[p][layer]
+		if score == 0 {
+			break
+		}
+		switch layer {
+		case diag:
+			moves = append(moves, diag)
+			s := la[t.codons[i-3]*let+t.qSeq[j-1]]
+			found := false
+		search:
+			for k := 0; k < 3 && i-3-k >= 0; k++ {
+				prev := table[p-(3+k)*c-1]
+				v := s
+				if k != 0 {
+					v += t.shift
+				}
+				for _, l := range []int{diag, up, left} {
+					if score == max2(prev[l], 0)+v {
+						if k != 0 {
+							moves = append(moves, byte(shift1+k-1))
+						}
+						i -= 3 + k
+						layer = l
+						found = true
+						break search
+					}
+				}
+			}
+			if !found {
+				panic(fmt.Sprintf("align: translated internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			j--
+		case up:
+			moves = append(moves, up)
+			switch score {
+			case table[p-3*c][up] + t.extend:
+			case table[p-3*c][diag] + t.open + t.extend:
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: translated internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			i -= 3
+		case left:
+			moves = append(moves, left)
+			switch score {
+			case table[p-1][left] + t.extend:
+			case table[p-1][diag] + t.open + t.extend:
+				layer = diag
+			default:
+				panic(fmt.Sprintf("align: translated internal error: no path at row: %d col:%d layer:%s\n", i, j, "mul"[layer:layer+1]))
+			}
+			j--
+		}
+	}
+	reverseMoves(moves)
+
+	return t.pairs(moves, i, j)
+}
+
+// pairs returns the feature pairs described by the alignment path in moves starting
+// from (i, j).
+func (t *translated) pairs(moves []byte, i, j int) []feat.Pair {
+	var aln []feat.Pair
+	for n := 0; n < len(moves); {
+		m := moves[n]
+		fp := &framePair{featPair{
+			a: feature{start: i, end: i},
+			b: feature{start: j, end: j},
+		}}
+		switch m {
+		case diag:
+			for ; n < len(moves) && moves[n] == m; n++ {
+				fp.score += t.la[t.codons[i]*t.let+t.qSeq[j]]
+				i += 3
+				j++
+			}
+		case up:
+			fp.score = t.open
+			for ; n < len(moves) && moves[n] == m; n++ {
+				fp.score += t.extend
+				i += 3
+			}
+		case left:
+			fp.score = t.open
+			for ; n < len(moves) && moves[n] == m; n++ {
+				fp.score += t.extend
+				j++
+			}
+		case shift1, shift2:
+			fp.score = t.shift
+			i += int(m-shift1) + 1
+			n++
+		}
+		fp.a.end, fp.b.end = i, j
+		aln = append(aln, fp)
+	}
+	if len(aln) == 0 {
+		aln = append(aln, &framePair{featPair{
+			a: feature{start: i, end: i},
+			b: feature{start: j, end: j},
+		}})
+	}
+	return aln
+}
@@ -0,0 +1,39 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleTranslated_Align() {
+	// The reference encodes MAKRYCDEWQHKFPIS with
+	// an extra base inserted after the seventh codon.
+	genomic := linear.NewSeq("genomic", alphabet.BytesToLetters([]byte("ggcATGGCGAAACGCTATTGCGATaGAATGGCAGCACAAATTTCCGATTAGCtaa")), alphabet.DNA)
+	protein := linear.NewSeq("protein", alphabet.BytesToLetters([]byte("MAKRYCDEWQHKFPIS")), alphabet.Protein)
+
+	translated := Translated{
+		Matrix:     matrix.BLOSUM62,
+		GapOpen:    -10,
+		GapExtend:  -1,
+		Frameshift: -15,
+	}
+	aln, err := translated.Align(genomic, protein)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, fp := range aln {
+		fmt.Printf("%v frame:%d\n", fp, fp.(Framer).Frame())
+	}
+	// Output:
+	// [3,24)/[0,7)=41 frame:0
+	// [24,25)/-=-15 frame:0
+	// [25,52)/[7,16)=55 frame:1
+}
@@ -0,0 +1,431 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package variant provides calling of sequence variants from multiple alignments
+// against a reference row.
+package variant
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/vcf"
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// An Alignment is a multiple alignment with named rows, such as a *multi.Multi or an
+// *alignment.Seq.
+type Alignment interface {
+	seq.Aligned
+	seq.Rower
+	Alphabet() alphabet.Alphabet
+}
+
+// Kind is the kind of a variant.
+type Kind int
+
+const (
+	// SNP is a single nucleotide substitution.
+	SNP Kind = iota
+
+	// Deletion is the deletion of one or more
+	// reference letters.
+	Deletion
+
+	// Insertion is the insertion of one or more
+	// letters between reference letters.
+	Insertion
+)
+
+func (k Kind) String() string {
+	switch k {
+	case SNP:
+		return "SNP"
+	case Deletion:
+		return "deletion"
+	case Insertion:
+		return "insertion"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Missing is the allele index and quality of a genotype that could not be determined.
+const Missing = vcf.Missing
+
+// A Genotype is the genotype of a single sample at a variant.
+type Genotype struct {
+	// Allele is the index of the sample's allele,
+	// zero for the reference allele and i for the
+	// ith alternative allele, or Missing.
+	Allele int
+
+	// MinBaseQuality is the minimum Phred quality
+	// of the sample's letters within the variant,
+	// or Missing if the sample has no letters there.
+	// It is not a genotype quality.
+	MinBaseQuality int
+}
+
+// A Variant is a sequence variant relative to a reference. Variant positions and alleles
+// follow VCF conventions: deletions and insertions include the reference letter preceding
+// the event, or following it when the event is at the start of the reference. A Variant
+// satisfies feat.Feature.
+type Variant struct {
+	// Chrom is the name of the reference.
+	Chrom string
+
+	// Pos is the zero-based position of the first
+	// letter of Ref in the reference.
+	Pos int
+
+	// ID is the identifier of the variant.
+	ID string
+
+	// Kind is the kind of the variant.
+	Kind Kind
+
+	// Ref and Alt are the reference and alternative
+	// alleles of the variant.
+	Ref string
+	Alt []string
+
+	// Qual is the maximum of the MinBaseQuality
+	// values of the samples carrying an alternative
+	// allele, or NaN if there are none.
+	Qual float64
+
+	// Genotypes holds the genotype of each sample,
+	// in sample order.
+	Genotypes []Genotype
+}
+
+func (v *Variant) Start() int             { return v.Pos }
+func (v *Variant) End() int               { return v.Pos + len(v.Ref) }
+func (v *Variant) Len() int               { return len(v.Ref) }
+func (v *Variant) Name() string           { return v.ID }
+func (v *Variant) Description() string    { return v.Kind.String() }
+func (v *Variant) Location() feat.Feature { return nil }
+
+// Record returns the variant as a VCF record.
+func (v *Variant) Record() *vcf.Record {
+	r := &vcf.Record{
+		Chrom:     v.Chrom,
+		Pos:       v.Pos,
+		ID:        v.ID,
+		Ref:       v.Ref,
+		Alt:       append([]string(nil), v.Alt...),
+		Qual:      v.Qual,
+		Genotypes: make([]vcf.Genotype, len(v.Genotypes)),
+	}
+	for i, g := range v.Genotypes {
+		r.Genotypes[i] = vcf.Genotype{Allele: g.Allele, MinBaseQuality: g.MinBaseQuality}
+	}
+	return r
+}
+
+// Caller calls variants from a multiple alignment against one of its rows. Each other
+// row of the alignment is treated as a haploid sample. Reference coordinates are given by
+// the position of the reference row in the alignment, so the first non-gap letter of the
+// reference row is at reference position Start() of the row, and each following non-gap
+// letter of the row is at the next reference position. An alignment can be placed at a
+// reference location by setting its offset. Alignment columns outside the reference row
+// are ignored.
+//
+// Gaps before the first and after the last non-gap letter of a sample row, positions not
+// covered by the row, ambiguous and invalid letters and letters with a quality below
+// MinQuality are treated as missing data. Samples with missing data within a variant have
+// a Missing genotype for that variant, as do samples with a different variant at the
+// same reference letters.
+type Caller struct {
+	// Reference is the index of the reference row.
+	Reference int
+
+	// MinQuality is the minimum quality of
+	// a letter that is not treated as missing.
+	MinQuality alphabet.Qphred
+}
+
+// Samples returns the names of the samples of a in the order of the genotypes of variants
+// returned by Call.
+func (c Caller) Samples(a Alignment) []string {
+	var n []string
+	for i := 0; i < a.Rows(); i++ {
+		if i != c.Reference {
+			n = append(n, a.Row(i).Name())
+		}
+	}
+	return n
+}
+
+// site is the state of a sample row at an alignment column.
+type site struct {
+	alphabet.QLetter
+	missing bool
+}
+
+// event is a variant in a single sample.
+type event struct {
+	kind Kind
+	pos  int
+	ref  string
+	alt  string
+
+	// from and to are the span of alignment
+	// columns of the variant, including the
+	// anchoring reference letter, and core is
+	// the span of columns altered by the event.
+	from, to int
+	core     [2]int
+}
+
+func (e event) key() string { return fmt.Sprintf("%d\x00%d\x00%s", e.kind, e.pos, e.ref) }
+
+// Call returns the variants in the samples of a relative to the reference row, sorted by
+// position. The reference allele letters are taken from the reference row and alleles are
+// upper case.
+func (c Caller) Call(a Alignment) ([]*Variant, error) {
+	if c.Reference < 0 || c.Reference >= a.Rows() {
+		return nil, fmt.Errorf("variant: reference row %d out of range", c.Reference)
+	}
+	alpha := a.Alphabet()
+	gap := alpha.Gap()
+	ref := a.Row(c.Reference)
+	start, end := ref.Start(), ref.End()
+
+	// cols holds the alignment column of each
+	// reference letter and letters holds the
+	// reference letters.
+	var (
+		cols    []int
+		letters []byte
+	)
+	for col := start; col < end; col++ {
+		if l := ref.At(col).L; l != gap {
+			cols = append(cols, col)
+			letters = append(letters, byte(l))
+		}
+	}
+	if len(cols) == 0 {
+		return nil, nil
+	}
+
+	var (
+		vs     []*Variant
+		index  = make(map[string]*Variant)
+		spans  = make(map[*Variant]event)
+		sites  [][]site
+		called []map[string]event
+	)
+	for i := 0; i < a.Rows(); i++ {
+		if i == c.Reference {
+			continue
+		}
+		s := c.sites(a.Row(i), alpha, start, end)
+		sites = append(sites, s)
+		ev := make(map[string]event)
+		for _, e := range events(s, alpha, cols, letters, start, end) {
+			k := e.key()
+			ev[k] = e
+			v, ok := index[k]
+			if !ok {
+				v = &Variant{
+					Chrom: ref.Name(),
+					Pos:   ref.Start() + e.pos,
+					Kind:  e.kind,
+					Ref:   e.ref,
+				}
+				index[k] = v
+				spans[v] = e
+				vs = append(vs, v)
+			}
+			var seen bool
+			for _, alt := range v.Alt {
+				if alt == e.alt {
+					seen = true
+					break
+				}
+			}
+			if !seen {
+				v.Alt = append(v.Alt, e.alt)
+			}
+		}
+		called = append(called, ev)
+	}
+
+	for _, v := range vs {
+		v.Qual = math.NaN()
+		v.Genotypes = make([]Genotype, len(sites))
+		for i, s := range sites {
+			g := &v.Genotypes[i]
+			g.Allele, g.MinBaseQuality = genotype(v, spans[v], s, called[i], start, gap)
+			if g.Allele > 0 && g.MinBaseQuality != Missing && (math.IsNaN(v.Qual) || float64(g.MinBaseQuality) > v.Qual) {
+				v.Qual = float64(g.MinBaseQuality)
+			}
+		}
+	}
+
+	sort.Slice(vs, func(i, j int) bool {
+		if vs[i].Pos != vs[j].Pos {
+			return vs[i].Pos < vs[j].Pos
+		}
+		if vs[i].Kind != vs[j].Kind {
+			return vs[i].Kind < vs[j].Kind
+		}
+		return len(vs[i].Ref) < len(vs[j].Ref)
+	})
+	return vs, nil
+}
+
+// sites returns the sites of the sample row r in the alignment columns from start to end.
+func (c Caller) sites(r seq.Sequence, alpha alphabet.Alphabet, start, end int) []site {
+	gap := alpha.Gap()
+	s := make([]site, end-start)
+	first, last := r.End(), r.Start()-1
+	for col := r.Start(); col < r.End(); col++ {
+		if r.At(col).L != gap {
+			if col < first {
+				first = col
+			}
+			last = col
+		}
+	}
+	for col := start; col < end; col++ {
+		if col < first || col > last {
+			s[col-start].missing = true
+			continue
+		}
+		ql := r.At(col)
+		s[col-start] = site{
+			QLetter: ql,
+			missing: ql.L != gap && (alpha.IndexOf(ql.L) < 0 || ql.L == alpha.Ambiguous() || ql.Q < c.MinQuality),
+		}
+	}
+	return s
+}
+
+// events returns the variants of the sample with sites s relative to the reference letters
+// at the alignment columns cols. Event positions are relative to the start of the reference.
+func events(s []site, alpha alphabet.Alphabet, cols []int, letters []byte, start, end int) []event {
+	gap := alpha.Gap()
+	refAllele := func(from, to int) string { return strings.ToUpper(string(letters[from:to])) }
+	var ev []event
+
+	// Substitutions and deletions.
+	for k := 0; k < len(cols); k++ {
+		st := s[cols[k]-start]
+		switch {
+		case st.missing:
+		case st.L != gap:
+			if alpha.IndexOf(st.L) != alpha.IndexOf(alphabet.Letter(letters[k])) {
+				ev = append(ev, event{
+					kind: SNP, pos: k,
+					ref: refAllele(k, k+1), alt: strings.ToUpper(string(st.L)),
+					from: cols[k], to: cols[k] + 1,
+					core: [2]int{cols[k], cols[k] + 1},
+				})
+			}
+		default:
+			j := k + 1
+			for j < len(cols) && !s[cols[j]-start].missing && s[cols[j]-start].L == gap {
+				j++
+			}
+			core := [2]int{cols[k], cols[j-1] + 1}
+			switch {
+			case k > 0:
+				ev = append(ev, event{
+					kind: Deletion, pos: k - 1,
+					ref: refAllele(k-1, j), alt: refAllele(k-1, k),
+					from: cols[k-1], to: core[1],
+					core: core,
+				})
+			case j < len(cols):
+				ev = append(ev, event{
+					kind: Deletion, pos: 0,
+					ref: refAllele(0, j+1), alt: refAllele(j, j+1),
+					from: cols[0], to: cols[j] + 1,
+					core: core,
+				})
+			}
+			k = j - 1
+		}
+	}
+
+	// Insertions, where k is the index of the reference
+	// letter following the inserted letters.
+	for k := 0; k <= len(cols); k++ {
+		from, to := start, end
+		if k > 0 {
+			from = cols[k-1] + 1
+		}
+		if k < len(cols) {
+			to = cols[k]
+		}
+		var (
+			ins     []byte
+			missing bool
+		)
+		for col := from; col < to; col++ {
+			st := s[col-start]
+			if st.missing {
+				missing = true
+				break
+			}
+			if st.L != gap {
+				ins = append(ins, byte(st.L))
+			}
+		}
+		if missing || len(ins) == 0 {
+			continue
+		}
+		alt := strings.ToUpper(string(ins))
+		if k > 0 {
+			ev = append(ev, event{
+				kind: Insertion, pos: k - 1,
+				ref: refAllele(k-1, k), alt: refAllele(k-1, k) + alt,
+				from: cols[k-1], to: to,
+				core: [2]int{from, to},
+			})
+		} else {
+			ev = append(ev, event{
+				kind: Insertion, pos: 0,
+				ref: refAllele(0, 1), alt: alt + refAllele(0, 1),
+				from: from, to: cols[0] + 1,
+				core: [2]int{from, to},
+			})
+		}
+	}
+	return ev
+}
+
+// genotype returns the allele index and quality of the sample with sites s and events ev
+// for the variant v described by the event e.
+func genotype(v *Variant, e event, s []site, ev map[string]event, start int, gap alphabet.Letter) (allele, quality int) {
+	quality = Missing
+	for col := e.from; col < e.to; col++ {
+		st := s[col-start]
+		if st.missing {
+			return Missing, Missing
+		}
+		if st.L != gap && (quality == Missing || int(st.Q) < quality) {
+			quality = int(st.Q)
+		}
+	}
+	if se, ok := ev[e.key()]; ok {
+		for i, alt := range v.Alt {
+			if alt == se.alt {
+				return i + 1, quality
+			}
+		}
+	}
+	for _, se := range ev {
+		if se.core[0] < e.core[1] && e.core[0] < se.core[1] {
+			return Missing, Missing
+		}
+	}
+	return 0, quality
+}
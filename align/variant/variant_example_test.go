@@ -0,0 +1,65 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package variant_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/biogo/biogo/align/variant"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/vcf"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+)
+
+func ExampleCaller() {
+	var rows []seq.Sequence
+	for _, r := range []struct{ name, seq string }{
+		{"chr1", "ACGTA-CGTA"},
+		{"s1", "ACTTA-CGTA"},
+		{"s2", "AC--A-CGTA"},
+		{"s3", "ACGTAGCGT-"},
+	} {
+		rows = append(rows, linear.NewSeq(r.name, alphabet.BytesToLetters([]byte(r.seq)), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("amplicon", rows, seq.DefaultConsensus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Place the alignment at position 100 of chr1.
+	m.SetOffset(100)
+
+	c := variant.Caller{Reference: 0}
+	vs, err := c.Call(m)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	w, err := vcf.NewWriter(os.Stdout, c.Samples(m))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, v := range vs {
+		_, err = w.Write(v.Record())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	// Output:
+	// ##fileformat=VCFv4.2
+	// ##FORMAT=<ID=GT,Number=1,Type=String,Description="Genotype">
+	// ##FORMAT=<ID=MINBQ,Number=1,Type=Integer,Description="Minimum base quality of the sample's letters in the variant">
+	// #CHROM	POS	ID	REF	ALT	QUAL	FILTER	INFO	FORMAT	s1	s2	s3
+	// chr1	102	.	CGT	C	40	.	.	GT:MINBQ	.:.	1:40	0:40
+	// chr1	103	.	G	T	40	.	.	GT:MINBQ	1:40	.:.	0:40
+	// chr1	105	.	A	AG	40	.	.	GT:MINBQ	0:40	0:40	1:40
+}
@@ -0,0 +1,188 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package variant
+
+import (
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/vcf"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type row struct {
+	name string
+	seq  string
+	q    alphabet.Qphred
+}
+
+func newMulti(c *check.C, rows ...row) *multi.Multi {
+	var sr []seq.Sequence
+	for _, r := range rows {
+		ql := make([]alphabet.QLetter, len(r.seq))
+		for i, l := range r.seq {
+			ql[i] = alphabet.QLetter{L: alphabet.Letter(l), Q: r.q}
+		}
+		sr = append(sr, linear.NewQSeq(r.name, ql, alphabet.DNAgapped, alphabet.Sanger))
+	}
+	m, err := multi.NewMulti("test", sr, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+type call struct {
+	pos       int
+	kind      Kind
+	ref       string
+	alt       []string
+	qual      float64
+	genotypes []Genotype
+}
+
+func calls(vs []*Variant) []call {
+	var c []call
+	for _, v := range vs {
+		c = append(c, call{
+			pos:       v.Pos,
+			kind:      v.Kind,
+			ref:       v.Ref,
+			alt:       v.Alt,
+			qual:      v.Qual,
+			genotypes: v.Genotypes,
+		})
+	}
+	return c
+}
+
+func (s *S) TestCall(c *check.C) {
+	m := newMulti(c,
+		row{"s1", "ACTTA-CGTA", 30},
+		row{"ref", "ACGTA-CGTA", 40},
+		row{"s2", "AC--A-CGTA", 30},
+		row{"s3", "acgtagcgta", 35},
+		row{"s4", "--GTA-CnTA", 30},
+		row{"s5", "ACTTA-CGTC", 20},
+	)
+	caller := Caller{Reference: 1}
+	c.Check(caller.Samples(m), check.DeepEquals, []string{"s1", "s2", "s3", "s4", "s5"})
+
+	vs, err := caller.Call(m)
+	c.Assert(err, check.Equals, nil)
+	for _, v := range vs {
+		c.Check(v.Chrom, check.Equals, "ref")
+	}
+	c.Check(calls(vs), check.DeepEquals, []call{
+		{
+			pos: 1, kind: Deletion, ref: "CGT", alt: []string{"C"}, qual: 30,
+			genotypes: []Genotype{{Missing, Missing}, {1, 30}, {0, 35}, {Missing, Missing}, {Missing, Missing}},
+		},
+		{
+			pos: 2, kind: SNP, ref: "G", alt: []string{"T"}, qual: 30,
+			genotypes: []Genotype{{1, 30}, {Missing, Missing}, {0, 35}, {0, 30}, {1, 20}},
+		},
+		{
+			pos: 4, kind: Insertion, ref: "A", alt: []string{"AG"}, qual: 35,
+			genotypes: []Genotype{{0, 30}, {0, 30}, {1, 35}, {0, 30}, {0, 20}},
+		},
+		{
+			pos: 8, kind: SNP, ref: "A", alt: []string{"C"}, qual: 20,
+			genotypes: []Genotype{{0, 30}, {0, 30}, {0, 35}, {0, 30}, {1, 20}},
+		},
+	})
+
+	caller.MinQuality = 25
+	vs, err = caller.Call(m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(len(vs), check.Equals, 3)
+	c.Check(vs[1].Genotypes[4], check.Equals, Genotype{Missing, Missing})
+	c.Check(vs[2].Genotypes[4], check.Equals, Genotype{Missing, Missing})
+
+	m.SetOffset(100)
+	caller.MinQuality = 0
+	vs, err = caller.Call(m)
+	c.Assert(err, check.Equals, nil)
+	for i, p := range []int{101, 102, 104, 108} {
+		c.Check(vs[i].Pos, check.Equals, p)
+		c.Check(vs[i].Start(), check.Equals, p)
+		c.Check(vs[i].End(), check.Equals, p+len(vs[i].Ref))
+	}
+
+	_, err = Caller{Reference: 6}.Call(m)
+	c.Check(err, check.ErrorMatches, "variant: reference row 6 out of range")
+}
+
+func (s *S) TestCallEnds(c *check.C) {
+	m := newMulti(c,
+		row{"ref", "--ACGTACG", 40},
+		row{"a", "TTACGTACG", 30},
+		row{"b", "---CGTACG", 30},
+		row{"c", "--AC-TA--", 30},
+		row{"d", "--G-GTACG", 30},
+	)
+	vs, err := Caller{}.Call(m)
+	c.Assert(err, check.Equals, nil)
+	for _, v := range vs {
+		v.Qual = 0
+	}
+	c.Check(calls(vs), check.DeepEquals, []call{
+		{
+			pos: 0, kind: SNP, ref: "A", alt: []string{"G"},
+			genotypes: []Genotype{{0, 30}, {Missing, Missing}, {0, 30}, {1, 30}},
+		},
+		{
+			pos: 0, kind: Deletion, ref: "AC", alt: []string{"A"},
+			genotypes: []Genotype{{0, 30}, {Missing, Missing}, {0, 30}, {1, 30}},
+		},
+		{
+			pos: 0, kind: Insertion, ref: "A", alt: []string{"TTA"},
+			genotypes: []Genotype{{1, 30}, {Missing, Missing}, {Missing, Missing}, {Missing, Missing}},
+		},
+		{
+			pos: 1, kind: Deletion, ref: "CG", alt: []string{"C"},
+			genotypes: []Genotype{{0, 30}, {0, 30}, {1, 30}, {0, 30}},
+		},
+	})
+}
+
+func (s *S) TestCallNoQuality(c *check.C) {
+	m, err := multi.NewMulti("test", []seq.Sequence{
+		linear.NewSeq("ref", alphabet.BytesToLetters([]byte("acgt")), alphabet.DNAgapped),
+		linear.NewSeq("a", alphabet.BytesToLetters([]byte("aggt")), alphabet.DNAgapped),
+	}, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	vs, err := Caller{}.Call(m)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(vs), check.Equals, 1)
+	c.Check(vs[0].Ref, check.Equals, "C")
+	c.Check(vs[0].Alt, check.DeepEquals, []string{"G"})
+	c.Check(vs[0].Qual, check.Equals, float64(seq.DefaultQphred))
+	c.Check(vs[0].Genotypes, check.DeepEquals, []Genotype{{1, int(seq.DefaultQphred)}})
+}
+
+func (s *S) TestRecord(c *check.C) {
+	v := &Variant{
+		Chrom: "chr1", Pos: 9, ID: "v1", Kind: SNP,
+		Ref: "G", Alt: []string{"T"}, Qual: 30,
+		Genotypes: []Genotype{{1, 30}, {Missing, Missing}},
+	}
+	r := v.Record()
+	c.Check(r, check.DeepEquals, &vcf.Record{
+		Chrom: "chr1", Pos: 9, ID: "v1",
+		Ref: "G", Alt: []string{"T"}, Qual: 30,
+		Genotypes: []vcf.Genotype{{Allele: 1, MinBaseQuality: 30}, {Allele: vcf.Missing, MinBaseQuality: vcf.Missing}},
+	})
+	r.Alt[0] = "C"
+	c.Check(v.Alt, check.DeepEquals, []string{"T"})
+}
@@ -158,6 +158,136 @@ func BenchmarkIsValidDNADirect(b *testing.B) {
 	}
 }
 
+func (s *S) TestCodon(c *check.C) {
+	c.Check(Codon.Len(), check.Equals, 66)
+	for i := 1; i <= 64; i++ {
+		b, ok := CodonBases(Codon.Letter(i))
+		c.Assert(ok, check.Equals, true)
+		cod := CodonOf(b[0], b[1], b[2])
+		c.Check(Codon.IndexOf(cod), check.Equals, i)
+		c.Check(Codon.IndexOf(cod), check.Equals, 1+16*DNA.IndexOf(b[0])+4*DNA.IndexOf(b[1])+DNA.IndexOf(b[2]))
+		c.Check(CodonOf(uc(b[0]), uc(b[1]), uc(b[2])), check.Equals, cod)
+	}
+	c.Check(CodonOf('A', 'u', 'g'), check.Equals, CodonOf('a', 't', 'g'))
+	c.Check(CodonOf('-', '-', '-'), check.Equals, Codon.Gap())
+	c.Check(CodonOf('a', 'n', 'g'), check.Equals, Codon.Ambiguous())
+	c.Check(CodonOf('a', '-', 'g'), check.Equals, Codon.Ambiguous())
+
+	cod := EncodeCodons(BytesToLetters([]byte("ATGnnn---tgaag")))
+	c.Check(len(cod), check.Equals, 4)
+	c.Check(cod[1:3], check.DeepEquals, Letters("x-"))
+	nuc, err := DecodeCodons(cod)
+	c.Check(err, check.Equals, nil)
+	c.Check(nuc.String(), check.Equals, "atgnnn---tga")
+	_, err = DecodeCodons(Letters("0z"))
+	c.Check(err, check.ErrorMatches, "alphabet: invalid codon letter 'z' at position 1")
+}
+
+func (s *S) TestGeneticCode(c *check.C) {
+	codon := func(s string) Letter { return CodonOf(Letter(s[0]), Letter(s[1]), Letter(s[2])) }
+	for _, t := range []struct {
+		codon string
+		aa    Letter
+		start bool
+	}{
+		{"atg", 'm', true},
+		{"ctg", 'l', true},
+		{"ttg", 'l', true},
+		{"tta", 'l', false},
+		{"ttt", 'f', false},
+		{"tgg", 'w', false},
+		{"taa", '*', false},
+		{"tag", '*', false},
+		{"tga", '*', false},
+		{"ggc", 'g', false},
+		{"ann", 'x', false},
+		{"---", '-', false},
+	} {
+		cod := codon(t.codon)
+		c.Check(Standard.Translate(cod), check.Equals, t.aa, check.Commentf("codon %s", t.codon))
+		c.Check(Standard.IsStart(cod), check.Equals, t.start, check.Commentf("codon %s", t.codon))
+		c.Check(Standard.IsStop(cod), check.Equals, t.aa == '*', check.Commentf("codon %s", t.codon))
+	}
+	var stops int
+	for i := 1; i <= 64; i++ {
+		if Standard.IsStop(Codon.Letter(i)) {
+			stops++
+		}
+	}
+	c.Check(stops, check.Equals, 3)
+
+	_, err := NewGeneticCode(0, "short", "FF", "--")
+	c.Check(err, check.ErrorMatches, "alphabet: genetic code tables must have length 64")
+	_, err = NewGeneticCode(0, "invalid", strings.Repeat("O", 64), strings.Repeat("-", 64))
+	c.Check(err, check.ErrorMatches, "alphabet: invalid amino acid 'O' in genetic code")
+}
+
+func (s *S) TestGeneticCodes(c *check.C) {
+	// NCBI does not define tables 7, 8, 15 and 17 to 20.
+	undefined := map[int]bool{7: true, 8: true, 15: true, 17: true, 18: true, 19: true, 20: true}
+	for id := 0; id <= 34; id++ {
+		g, ok := GeneticCodeByID(id)
+		if id < 1 || id > 33 || undefined[id] {
+			c.Check(ok, check.Equals, false, check.Commentf("table %d", id))
+			continue
+		}
+		c.Assert(ok, check.Equals, true, check.Commentf("table %d", id))
+		c.Check(g.ID, check.Equals, id)
+		c.Check(g.Name, check.Not(check.Equals), "")
+	}
+
+	codon := func(s string) Letter { return CodonOf(Letter(s[0]), Letter(s[1]), Letter(s[2])) }
+	for _, t := range []struct {
+		g     *GeneticCode
+		codon string
+		aa    Letter
+		start bool
+	}{
+		{g: VertebrateMitochondrial, codon: "tga", aa: 'w'},
+		{g: VertebrateMitochondrial, codon: "aga", aa: '*'},
+		{g: VertebrateMitochondrial, codon: "ata", aa: 'm', start: true},
+		{g: YeastMitochondrial, codon: "ctg", aa: 't'},
+		{g: CiliateNuclear, codon: "taa", aa: 'q'},
+		{g: Bacterial, codon: "gtg", aa: 'v', start: true},
+		{g: Bacterial, codon: "tga", aa: '*'},
+		{g: AscidianMitochondrial, codon: "agg", aa: 'g'},
+		{g: ChlorophyceanMitochondrial, codon: "tag", aa: 'l'},
+		{g: CandidateDivisionSR1, codon: "tga", aa: 'g'},
+		{g: PachysolenNuclear, codon: "ctg", aa: 'a', start: true},
+		{g: BalanophoraceaePlastid, codon: "tag", aa: 'w'},
+		{g: BalanophoraceaePlastid, codon: "tga", aa: '*'},
+		{g: BalanophoraceaePlastid, codon: "att", aa: 'i', start: true},
+	} {
+		cod := codon(t.codon)
+		c.Check(t.g.Translate(cod), check.Equals, t.aa, check.Commentf("table %d codon %s", t.g.ID, t.codon))
+		c.Check(t.g.IsStart(cod), check.Equals, t.start, check.Commentf("table %d codon %s", t.g.ID, t.codon))
+	}
+}
+
+func (s *S) TestTranslateBases(c *check.C) {
+	for _, t := range []struct {
+		codon string
+		aa    Letter
+	}{
+		{"atg", 'm'},
+		{"AUG", 'm'},
+		{"ggn", 'g'},
+		{"ytr", 'l'},
+		{"tar", '*'},
+		{"rat", 'b'},
+		{"saa", 'z'},
+		{"mtt", 'j'},
+		{"ran", 'x'},
+		{"trr", 'x'},
+		{"a-g", 'x'},
+		{"ajg", 'x'},
+		{"---", '-'},
+	} {
+		c.Check(Standard.TranslateBases(Letter(t.codon[0]), Letter(t.codon[1]), Letter(t.codon[2])), check.Equals, t.aa,
+			check.Commentf("codon %s", t.codon))
+	}
+}
+
 func BenchmarkIndexDNA(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		DNA.IndexOf(Letter(i))
@@ -0,0 +1,185 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+)
+
+// codonLetters holds the gap, the 64 codon letters and the ambiguous codon letter
+// of the Codon alphabet. Codon letters are the 64 contiguous ASCII characters
+// from '0' to 'o'.
+var codonLetters = func() string {
+	b := make([]byte, 0, 66)
+	b = append(b, '-')
+	for c := 0; c < 64; c++ {
+		b = append(b, byte(firstCodon+c))
+	}
+	return string(append(b, 'x'))
+}()
+
+const firstCodon = '0'
+
+// Codon is the codon alphabet. Each of the 64 codons is represented by a single letter
+// so that sequences of codons can be held and aligned in the same way as other sequences.
+// The index of a codon letter in the alphabet is 1 + 16×b1 + 4×b2 + b3 where b1, b2 and
+// b3 are the indices of the codon's bases in the DNA alphabet. The gap letter is '-' at
+// index zero and codons including ambiguous bases are represented by 'x', the last letter
+// of the alphabet. Codon letters are obtained from bases using CodonOf and EncodeCodons.
+var Codon = Must(NewAlphabet(
+	codonLetters,
+	feat.DNA,
+	'-', 'x',
+	CaseSensitive,
+))
+
+// baseIndex returns the DNA alphabet index of a base, treating u as t, or -1 if the
+// base is not a, c, g, t or u.
+func baseIndex(b Letter) int {
+	switch b | ('a' - 'A') {
+	case 'a':
+		return 0
+	case 'c':
+		return 1
+	case 'g':
+		return 2
+	case 't', 'u':
+		return 3
+	}
+	return -1
+}
+
+// CodonOf returns the Codon alphabet letter for the codon b1b2b3. Bases may be DNA or RNA
+// in either case. If all three bases are gaps CodonOf returns the gap letter, '-', and if
+// any base is ambiguous or a gap it returns the ambiguous codon letter, 'x'.
+func CodonOf(b1, b2, b3 Letter) Letter {
+	if b1 == '-' && b2 == '-' && b3 == '-' {
+		return '-'
+	}
+	i1, i2, i3 := baseIndex(b1), baseIndex(b2), baseIndex(b3)
+	if i1 < 0 || i2 < 0 || i3 < 0 {
+		return 'x'
+	}
+	return Letter(firstCodon + (i1<<4 | i2<<2 | i3))
+}
+
+// CodonBases returns the lower case DNA bases of the Codon alphabet letter c. The gap
+// letter returns three gaps and the ambiguous codon letter returns three n bases. If c is
+// not a valid Codon letter, ok is false.
+func CodonBases(c Letter) (b [3]Letter, ok bool) {
+	switch {
+	case c == '-':
+		return [3]Letter{'-', '-', '-'}, true
+	case c == 'x':
+		return [3]Letter{'n', 'n', 'n'}, true
+	case c < firstCodon || c >= firstCodon+64:
+		return b, false
+	}
+	const bases = "acgt"
+	i := int(c - firstCodon)
+	return [3]Letter{Letter(bases[i>>4]), Letter(bases[i>>2&3]), Letter(bases[i&3])}, true
+}
+
+// EncodeCodons returns the Codon alphabet letters for the complete codons of the nucleic
+// acid letters in s read from the first position. Trailing bases that do not make up a
+// complete codon are ignored.
+func EncodeCodons(s []Letter) Letters {
+	c := make(Letters, len(s)/3)
+	for i := range c {
+		c[i] = CodonOf(s[3*i], s[3*i+1], s[3*i+2])
+	}
+	return c
+}
+
+// DecodeCodons returns the lower case DNA bases of the Codon alphabet letters in c. It
+// returns an error if c contains a letter that is not valid in the Codon alphabet.
+func DecodeCodons(c []Letter) (Letters, error) {
+	s := make(Letters, 0, 3*len(c))
+	for i, l := range c {
+		b, ok := CodonBases(l)
+		if !ok {
+			return nil, fmt.Errorf("alphabet: invalid codon letter %q at position %d", l, i)
+		}
+		s = append(s, b[:]...)
+	}
+	return s, nil
+}
+
+// A GeneticCode is a translation table between codons and amino acids.
+type GeneticCode struct {
+	// ID is the NCBI translation table identifier.
+	ID int
+	// Name is the name of the genetic code.
+	Name string
+
+	aa    [64]Letter
+	start [64]bool
+}
+
+// NewGeneticCode returns a new GeneticCode from the amino acid and start codon strings in
+// the format used by the NCBI genetic code tables. Both strings must have length 64 and
+// be ordered by codon with bases in the order t, c, a, g; aminoAcids holds the single letter
+// amino acid code for each codon with '*' for stop codons, and starts holds 'M' for each
+// codon that may be used as an initiation codon.
+func NewGeneticCode(id int, name, aminoAcids, starts string) (*GeneticCode, error) {
+	if len(aminoAcids) != 64 || len(starts) != 64 {
+		return nil, errors.New("alphabet: genetic code tables must have length 64")
+	}
+	g := &GeneticCode{ID: id, Name: name}
+	const tcag = "tcag"
+	for i := 0; i < 64; i++ {
+		c := CodonOf(Letter(tcag[i>>4]), Letter(tcag[i>>2&3]), Letter(tcag[i&3])) - firstCodon
+		aa := Letter(aminoAcids[i]) | ('a' - 'A')
+		if aminoAcids[i] == '*' {
+			aa = '*'
+		} else if !Protein.IsValid(aa) || aa == Protein.Gap() {
+			return nil, fmt.Errorf("alphabet: invalid amino acid %q in genetic code", aminoAcids[i])
+		}
+		g.aa[c] = aa
+		g.start[c] = starts[i] == 'M'
+	}
+	return g, nil
+}
+
+// MustGeneticCode is a helper that wraps a call to a function returning (*GeneticCode, error)
+// and panics if the error is non-nil. It is intended for use in variable initializations.
+func MustGeneticCode(g *GeneticCode, err error) *GeneticCode {
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// Standard is the standard genetic code, NCBI translation table 1.
+var Standard = MustGeneticCode(NewGeneticCode(1, "Standard",
+	"FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+	"---M------**--*----M---------------M----------------------------",
+))
+
+// Translate returns the lower case Protein alphabet letter for the Codon alphabet letter
+// c. Stop codons return '*', the gap letter returns the gap and ambiguous or invalid codon
+// letters return 'x'.
+func (g *GeneticCode) Translate(c Letter) Letter {
+	switch {
+	case c == '-':
+		return '-'
+	case c < firstCodon || c >= firstCodon+64:
+		return 'x'
+	}
+	return g.aa[c-firstCodon]
+}
+
+// IsStart returns whether the Codon alphabet letter c is an initiation codon.
+func (g *GeneticCode) IsStart(c Letter) bool {
+	return firstCodon <= c && c < firstCodon+64 && g.start[c-firstCodon]
+}
+
+// IsStop returns whether the Codon alphabet letter c is a stop codon.
+func (g *GeneticCode) IsStop(c Letter) bool {
+	return g.Translate(c) == '*'
+}
@@ -0,0 +1,241 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+// The NCBI translation tables. Tables 27, 28 and 31 have codons that are translated as
+// stops only at the end of a coding sequence; these codons are translated here as their
+// sense amino acids.
+var (
+	VertebrateMitochondrial = MustGeneticCode(NewGeneticCode(2, "Vertebrate Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSS**VVVVAAAADDEEGGGG",
+		"----------**--------------------MMMM----------**---M------------",
+	))
+	YeastMitochondrial = MustGeneticCode(NewGeneticCode(3, "Yeast Mitochondrial",
+		"FFLLSSSSYY**CCWWTTTTPPPPHHQQRRRRIIMMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**----------------------MM---------------M------------",
+	))
+	MoldMitochondrial = MustGeneticCode(NewGeneticCode(4, "Mold, Protozoan, and Coelenterate Mitochondrial and Mycoplasma/Spiroplasma",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--MM------**-------M------------MMMM---------------M------------",
+	))
+	InvertebrateMitochondrial = MustGeneticCode(NewGeneticCode(5, "Invertebrate Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSSSVVVVAAAADDEEGGGG",
+		"---M------**--------------------MMMM---------------M------------",
+	))
+	CiliateNuclear = MustGeneticCode(NewGeneticCode(6, "Ciliate, Dasycladacean and Hexamita Nuclear",
+		"FFLLSSSSYYQQCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--------------*--------------------M----------------------------",
+	))
+	EchinodermMitochondrial = MustGeneticCode(NewGeneticCode(9, "Echinoderm and Flatworm Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"----------**-----------------------M---------------M------------",
+	))
+	EuplotidNuclear = MustGeneticCode(NewGeneticCode(10, "Euplotid Nuclear",
+		"FFLLSSSSYY**CCCWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**-----------------------M----------------------------",
+	))
+	Bacterial = MustGeneticCode(NewGeneticCode(11, "Bacterial, Archaeal and Plant Plastid",
+		"FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M------**--*----M------------MMMM---------------M------------",
+	))
+	AlternativeYeastNuclear = MustGeneticCode(NewGeneticCode(12, "Alternative Yeast Nuclear",
+		"FFLLSSSSYY**CC*WLLLSPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**--*----M---------------M----------------------------",
+	))
+	AscidianMitochondrial = MustGeneticCode(NewGeneticCode(13, "Ascidian Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSSGGVVVVAAAADDEEGGGG",
+		"---M------**----------------------MM---------------M------------",
+	))
+	AlternativeFlatwormMitochondrial = MustGeneticCode(NewGeneticCode(14, "Alternative Flatworm Mitochondrial",
+		"FFLLSSSSYYY*CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"-----------*-----------------------M----------------------------",
+	))
+	ChlorophyceanMitochondrial = MustGeneticCode(NewGeneticCode(16, "Chlorophycean Mitochondrial",
+		"FFLLSSSSYY*LCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------*---*--------------------M----------------------------",
+	))
+	TrematodeMitochondrial = MustGeneticCode(NewGeneticCode(21, "Trematode Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNNKSSSSVVVVAAAADDEEGGGG",
+		"----------**-----------------------M---------------M------------",
+	))
+	ScenedesmusMitochondrial = MustGeneticCode(NewGeneticCode(22, "Scenedesmus obliquus Mitochondrial",
+		"FFLLSS*SYY*LCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"------*---*---*--------------------M----------------------------",
+	))
+	ThraustochytriumMitochondrial = MustGeneticCode(NewGeneticCode(23, "Thraustochytrium Mitochondrial",
+		"FF*LSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--*-------**--*-----------------M--M---------------M------------",
+	))
+	RhabdopleuridaeMitochondrial = MustGeneticCode(NewGeneticCode(24, "Rhabdopleuridae Mitochondrial",
+		"FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSSKVVVVAAAADDEEGGGG",
+		"---M------**-------M---------------M---------------M------------",
+	))
+	CandidateDivisionSR1 = MustGeneticCode(NewGeneticCode(25, "Candidate Division SR1 and Gracilibacteria",
+		"FFLLSSSSYY**CCGWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M------**-----------------------M---------------M------------",
+	))
+	PachysolenNuclear = MustGeneticCode(NewGeneticCode(26, "Pachysolen tannophilus Nuclear",
+		"FFLLSSSSYY**CC*WLLLAPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**--*----M---------------M----------------------------",
+	))
+	KaryorelictNuclear = MustGeneticCode(NewGeneticCode(27, "Karyorelict Nuclear",
+		"FFLLSSSSYYQQCCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--------------*--------------------M----------------------------",
+	))
+	CondylostomaNuclear = MustGeneticCode(NewGeneticCode(28, "Condylostoma Nuclear",
+		"FFLLSSSSYYQQCCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**--*--------------------M----------------------------",
+	))
+	MesodiniumNuclear = MustGeneticCode(NewGeneticCode(29, "Mesodinium Nuclear",
+		"FFLLSSSSYYYYCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--------------*--------------------M----------------------------",
+	))
+	PeritrichNuclear = MustGeneticCode(NewGeneticCode(30, "Peritrich Nuclear",
+		"FFLLSSSSYYEECC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"--------------*--------------------M----------------------------",
+	))
+	BlastocrithidiaNuclear = MustGeneticCode(NewGeneticCode(31, "Blastocrithidia Nuclear",
+		"FFLLSSSSYYEECCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"----------**-----------------------M----------------------------",
+	))
+	BalanophoraceaePlastid = MustGeneticCode(NewGeneticCode(32, "Balanophoraceae Plastid",
+		"FFLLSSSSYY*WCC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG",
+		"---M------*---*----M------------MMMM---------------M------------",
+	))
+	CephalodiscidaeMitochondrial = MustGeneticCode(NewGeneticCode(33, "Cephalodiscidae Mitochondrial",
+		"FFLLSSSSYYY*CCWWLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSSKVVVVAAAADDEEGGGG",
+		"---M-------*-------M---------------M---------------M------------",
+	))
+)
+
+var geneticCodes = func() map[int]*GeneticCode {
+	m := make(map[int]*GeneticCode)
+	for _, g := range []*GeneticCode{
+		Standard,
+		VertebrateMitochondrial,
+		YeastMitochondrial,
+		MoldMitochondrial,
+		InvertebrateMitochondrial,
+		CiliateNuclear,
+		EchinodermMitochondrial,
+		EuplotidNuclear,
+		Bacterial,
+		AlternativeYeastNuclear,
+		AscidianMitochondrial,
+		AlternativeFlatwormMitochondrial,
+		ChlorophyceanMitochondrial,
+		TrematodeMitochondrial,
+		ScenedesmusMitochondrial,
+		ThraustochytriumMitochondrial,
+		RhabdopleuridaeMitochondrial,
+		CandidateDivisionSR1,
+		PachysolenNuclear,
+		KaryorelictNuclear,
+		CondylostomaNuclear,
+		MesodiniumNuclear,
+		PeritrichNuclear,
+		BlastocrithidiaNuclear,
+		BalanophoraceaePlastid,
+		CephalodiscidaeMitochondrial,
+	} {
+		m[g.ID] = g
+	}
+	return m
+}()
+
+// GeneticCodeByID returns the NCBI translation table with the given identifier. If there is
+// no table with the identifier, ok is false.
+func GeneticCodeByID(id int) (g *GeneticCode, ok bool) {
+	g, ok = geneticCodes[id]
+	return g, ok
+}
+
+// redundantBases returns the set of bases, as a bit mask indexed by DNA alphabet index,
+// represented by the nucleic acid redundancy code b, or zero if b is not a redundancy code.
+func redundantBases(b Letter) int {
+	const a, c, g, t = 1, 2, 4, 8
+	switch b | ('a' - 'A') {
+	case 'a':
+		return a
+	case 'c':
+		return c
+	case 'g':
+		return g
+	case 't', 'u':
+		return t
+	case 'r':
+		return a | g
+	case 'y':
+		return c | t
+	case 's':
+		return c | g
+	case 'w':
+		return a | t
+	case 'k':
+		return g | t
+	case 'm':
+		return a | c
+	case 'b':
+		return c | g | t
+	case 'd':
+		return a | g | t
+	case 'h':
+		return a | c | t
+	case 'v':
+		return a | c | g
+	case 'n':
+		return a | c | g | t
+	}
+	return 0
+}
+
+// TranslateBases returns the lower case Protein alphabet letter for the codon b1b2b3.
+// Bases may be DNA or RNA in either case and may be nucleic acid redundancy codes such
+// as those of the DNAredundant alphabet. A codon with redundant bases is translated to
+// the amino acid or stop shared by all the codons it represents, or to b, z or j if the
+// codons translate only to d and n, e and q, or i and l respectively. Three gaps return
+// the gap and all other codons return 'x'.
+func (g *GeneticCode) TranslateBases(b1, b2, b3 Letter) Letter {
+	if b1 == '-' && b2 == '-' && b3 == '-' {
+		return '-'
+	}
+	m1, m2, m3 := redundantBases(b1), redundantBases(b2), redundantBases(b3)
+	if m1 == 0 || m2 == 0 || m3 == 0 {
+		return 'x'
+	}
+	var seen [256]bool
+	for i1 := 0; i1 < 4; i1++ {
+		if m1&(1<<i1) == 0 {
+			continue
+		}
+		for i2 := 0; i2 < 4; i2++ {
+			if m2&(1<<i2) == 0 {
+				continue
+			}
+			for i3 := 0; i3 < 4; i3++ {
+				if m3&(1<<i3) != 0 {
+					seen[g.aa[i1<<4|i2<<2|i3]] = true
+				}
+			}
+		}
+	}
+	var aa []Letter
+	for l, ok := range seen {
+		if ok {
+			aa = append(aa, Letter(l))
+		}
+	}
+	switch {
+	case len(aa) == 1:
+		return aa[0]
+	case len(aa) == 2 && aa[0] == 'd' && aa[1] == 'n':
+		return 'b'
+	case len(aa) == 2 && aa[0] == 'e' && aa[1] == 'q':
+		return 'z'
+	case len(aa) == 2 && aa[0] == 'i' && aa[1] == 'l':
+		return 'j'
+	}
+	return 'x'
+}
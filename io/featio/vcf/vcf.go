@@ -0,0 +1,163 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vcf provides types to write Variant Call Format files.
+//
+// The specification can be found at https://samtools.github.io/hts-specs/VCFv4.2.pdf.
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var _ featio.Writer = (*Writer)(nil)
+
+// Version is the VCF version that is written.
+const Version = "VCFv4.2"
+
+var (
+	ErrNotHandled     = errors.New("vcf: type not handled")
+	ErrSampleMismatch = errors.New("vcf: number of genotypes does not match number of samples")
+)
+
+// Missing is the value of a genotype field that is not known.
+const Missing = -1
+
+// A Genotype is the haploid genotype of a single sample in a VCF record.
+type Genotype struct {
+	// Allele is the index of the sample's allele,
+	// zero for the reference allele and i for the
+	// ith alternative allele, or Missing.
+	Allele int
+
+	// MinBaseQuality is the minimum Phred base
+	// quality of the sample's letters within the
+	// record, or Missing. It is written as the
+	// MINBQ FORMAT field.
+	MinBaseQuality int
+}
+
+// A Record is a VCF data line. Record satisfies feat.Feature.
+type Record struct {
+	// Chrom is the name of the reference.
+	Chrom string
+
+	// Pos is the zero-based position of the first
+	// letter of Ref in the reference.
+	Pos int
+
+	// ID is the identifier of the record.
+	ID string
+
+	// Ref and Alt are the reference and alternative
+	// alleles of the record.
+	Ref string
+	Alt []string
+
+	// Qual is the Phred-scaled quality of the record,
+	// or NaN if the quality is not known.
+	Qual float64
+
+	// Genotypes holds the genotype of each sample,
+	// in sample order.
+	Genotypes []Genotype
+}
+
+func (r *Record) Start() int             { return r.Pos }
+func (r *Record) End() int               { return r.Pos + len(r.Ref) }
+func (r *Record) Len() int               { return len(r.Ref) }
+func (r *Record) Name() string           { return r.ID }
+func (r *Record) Description() string    { return "VCF record" }
+func (r *Record) Location() feat.Feature { return nil }
+
+// A Writer outputs variants in VCF format.
+type Writer struct {
+	w       io.Writer
+	samples int
+
+	// Precision is the number of decimal places used
+	// to write variant qualities. A negative value
+	// writes the smallest number of places needed.
+	Precision int
+}
+
+// NewWriter returns a new VCF format writer using w. The VCF header is written, with a
+// genotype column for each of the named samples. Any error that occurs while writing the
+// header is returned.
+func NewWriter(w io.Writer, samples []string) (*Writer, error) {
+	vw := &Writer{w: w, samples: len(samples), Precision: -1}
+	h := []string{
+		"##fileformat=" + Version,
+		`##FORMAT=<ID=GT,Number=1,Type=String,Description="Genotype">`,
+		`##FORMAT=<ID=MINBQ,Number=1,Type=Integer,Description="Minimum base quality of the sample's letters in the variant">`,
+		"#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO",
+	}
+	if len(samples) != 0 {
+		h[len(h)-1] += "\tFORMAT\t" + strings.Join(samples, "\t")
+	}
+	_, err := io.WriteString(w, strings.Join(h, "\n")+"\n")
+	if err != nil {
+		return nil, err
+	}
+	return vw, nil
+}
+
+// Write writes a single *Record as a VCF data line and returns the number of bytes written
+// and any error. Genotypes are written as haploid GT and MINBQ fields. All other
+// feat.Feature types return an ErrNotHandled.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	v, ok := f.(*Record)
+	if !ok {
+		return 0, ErrNotHandled
+	}
+	if len(v.Genotypes) != w.samples {
+		return 0, ErrSampleMismatch
+	}
+
+	qual := "."
+	if !math.IsNaN(v.Qual) {
+		qual = strconv.FormatFloat(v.Qual, 'f', w.Precision, 64)
+	}
+	alt := "."
+	if len(v.Alt) != 0 {
+		alt = strings.Join(v.Alt, ",")
+	}
+	line := fmt.Sprintf("%s\t%d\t%s\t%s\t%s\t%s\t.\t.",
+		v.Chrom,
+		feat.ZeroToOne(v.Pos),
+		missing(v.ID),
+		v.Ref,
+		alt,
+		qual,
+	)
+	if w.samples != 0 {
+		line += "\tGT:MINBQ"
+		for _, g := range v.Genotypes {
+			line += "\t" + field(g.Allele) + ":" + field(g.MinBaseQuality)
+		}
+	}
+	return io.WriteString(w.w, line+"\n")
+}
+
+func missing(s string) string {
+	if s == "" {
+		return "."
+	}
+	return s
+}
+
+func field(i int) string {
+	if i == Missing {
+		return "."
+	}
+	return strconv.Itoa(i)
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const header = `##fileformat=VCFv4.2
+##FORMAT=<ID=GT,Number=1,Type=String,Description="Genotype">
+##FORMAT=<ID=MINBQ,Number=1,Type=Integer,Description="Minimum base quality of the sample's letters in the variant">
+`
+
+func (s *S) TestWriter(c *check.C) {
+	rs := []*Record{
+		{
+			Chrom: "chr1", Pos: 9,
+			Ref: "G", Alt: []string{"T", "C"}, Qual: 30,
+			Genotypes: []Genotype{{Allele: 1, MinBaseQuality: 30}, {Allele: 2, MinBaseQuality: 20}},
+		},
+		{
+			Chrom: "chr1", Pos: 19, ID: "del1",
+			Ref: "CGT", Alt: []string{"C"}, Qual: math.NaN(),
+			Genotypes: []Genotype{{Allele: 0, MinBaseQuality: 40}, {Allele: Missing, MinBaseQuality: Missing}},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, []string{"a", "b"})
+	c.Assert(err, check.Equals, nil)
+	for _, r := range rs {
+		c.Check(r.End()-r.Start(), check.Equals, len(r.Ref))
+		_, err = w.Write(r)
+		c.Check(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, header+
+		"#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\tFORMAT\ta\tb\n"+
+		"chr1\t10\t.\tG\tT,C\t30\t.\t.\tGT:MINBQ\t1:30\t2:20\n"+
+		"chr1\t20\tdel1\tCGT\tC\t.\t.\t.\tGT:MINBQ\t0:40\t.:.\n",
+	)
+
+	buf.Reset()
+	w, err = NewWriter(buf, nil)
+	c.Assert(err, check.Equals, nil)
+	w.Precision = 1
+	n, err := w.Write(&Record{Chrom: "chr2", Pos: 0, Ref: "A", Qual: 12.34})
+	c.Check(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, header+
+		"#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\n"+
+		"chr2\t1\t.\tA\t.\t12.3\t.\t.\n",
+	)
+	c.Check(n, check.Equals, len("chr2\t1\t.\tA\t.\t12.3\t.\t.\n"))
+
+	_, err = w.Write(rs[0])
+	c.Check(err, check.Equals, ErrSampleMismatch)
+	_, err = w.Write(feat.Feature(nil))
+	c.Check(err, check.Equals, ErrNotHandled)
+}
@@ -0,0 +1,245 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pattern implements searching nucleic acid sequences for patterns of IUPAC
+// nucleotide codes, allowing mismatches, using the Shift-And (bitap) algorithm.
+package pattern
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+)
+
+// MaxLen is the maximum length of a pattern.
+const MaxLen = 64
+
+// iupac maps IUPAC nucleotide codes to the set of bases they represent. The set is the
+// index of the code in alphabet.DNAredundant, with bits a=1, c=2, g=4 and t=8, and U is
+// treated as T. Letters that are not IUPAC nucleotide codes map to 0.
+var iupac = func() (t [256]uint8) {
+	for l := range t {
+		if i := alphabet.DNAredundant.IndexOf(alphabet.Letter(l)); i > 0 {
+			t[l] = uint8(i)
+		}
+	}
+	t['u'], t['U'] = t['t'], t['T']
+	return t
+}()
+
+// complement returns the complement of the base set b.
+func complement(b uint8) uint8 {
+	c, _ := alphabet.DNAredundant.Complement(alphabet.DNAredundant.Letter(int(b)))
+	return iupac[c]
+}
+
+// A Pattern is a compiled IUPAC nucleotide pattern.
+//
+// A letter of a searched sequence matches a pattern position if every base represented
+// by the letter is represented by the pattern letter at that position. So an R in a
+// pattern matches A, G and R, while an A in a pattern does not match N. Gaps and letters
+// that are not IUPAC nucleotide codes match no pattern position.
+type Pattern struct {
+	pattern    string
+	mismatches int
+
+	// fwd and rev hold the Shift-And letter masks for the
+	// pattern and its reverse complement. rev is nil if the
+	// pattern is its own reverse complement.
+	fwd, rev *[256]uint64
+}
+
+// Compile returns a Pattern for the IUPAC nucleotide pattern, matching with at most
+// the given number of mismatches.
+func Compile(pattern string, mismatches int) (*Pattern, error) {
+	n := len(pattern)
+	if n == 0 {
+		return nil, errors.New("pattern: empty pattern")
+	}
+	if n > MaxLen {
+		return nil, fmt.Errorf("pattern: pattern longer than %d", MaxLen)
+	}
+	if mismatches < 0 || mismatches >= n {
+		return nil, fmt.Errorf("pattern: invalid mismatch count %d", mismatches)
+	}
+	fwd := make([]uint8, n)
+	rev := make([]uint8, n)
+	palindrome := true
+	for i := 0; i < n; i++ {
+		b := iupac[pattern[i]]
+		if b == 0 {
+			return nil, fmt.Errorf("pattern: invalid letter %q in pattern", pattern[i])
+		}
+		fwd[i] = b
+		rev[n-1-i] = complement(b)
+	}
+	for i := range fwd {
+		if fwd[i] != rev[i] {
+			palindrome = false
+			break
+		}
+	}
+	p := &Pattern{pattern: pattern, mismatches: mismatches, fwd: masks(fwd)}
+	if !palindrome {
+		p.rev = masks(rev)
+	}
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be compiled.
+func MustCompile(pattern string, mismatches int) *Pattern {
+	p, err := Compile(pattern, mismatches)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// masks returns the Shift-And letter masks for the pattern base sets in p. Bit i of
+// the mask for a letter is set if the letter matches position i of the pattern.
+func masks(p []uint8) *[256]uint64 {
+	var m [256]uint64
+	for l := range m {
+		b := iupac[l]
+		if b == 0 {
+			continue
+		}
+		for i, pb := range p {
+			if b&^pb == 0 {
+				m[l] |= 1 << uint(i)
+			}
+		}
+	}
+	return &m
+}
+
+// String returns the uncompiled pattern.
+func (p *Pattern) String() string { return p.pattern }
+
+// Len returns the length of the pattern.
+func (p *Pattern) Len() int { return len(p.pattern) }
+
+// Mismatches returns the maximum number of mismatches allowed in a match.
+func (p *Pattern) Mismatches() int { return p.mismatches }
+
+// A Match is a match of a Pattern to a sequence. Match coordinates are relative to the
+// forward strand of the sequence for matches in either orientation. Matches spanning the
+// origin of a circular sequence have a From position greater than their To position, as
+// used by sequtils.Truncate.
+type Match struct {
+	// From and To are the start and end of the match.
+	From, To int
+
+	// Loc is the sequence holding the match.
+	Loc feat.Feature
+
+	// Orient is the orientation of the match relative
+	// to the sequence. Matches of the reverse complement
+	// of the pattern are reverse.
+	Orient feat.Orientation
+
+	// Mismatches is the number of mismatched positions
+	// in the match.
+	Mismatches int
+
+	// Pattern is the matched pattern.
+	Pattern *Pattern
+}
+
+func (m *Match) Start() int                    { return m.From }
+func (m *Match) End() int                      { return m.To }
+func (m *Match) Len() int                      { return m.Pattern.Len() }
+func (m *Match) Name() string                  { return m.Pattern.String() }
+func (m *Match) Description() string           { return "pattern match" }
+func (m *Match) Location() feat.Feature        { return m.Loc }
+func (m *Match) Orientation() feat.Orientation { return m.Orient }
+
+// Search returns the matches of the pattern and its reverse complement in s, sorted by
+// From position with forward matches before reverse matches at the same position.
+// Overlapping matches are all reported. Matches of patterns that are their own reverse
+// complement are reported once, in the forward orientation. If s is circular and longer
+// than the pattern, matches may span the origin.
+func (p *Pattern) Search(s seq.Sequence) ([]*Match, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("pattern: sequence is not a nucleic acid")
+	}
+
+	var (
+		n, m = s.Len(), p.Len()
+		end  = n
+	)
+	if s.Conformation() == feat.Circular && m < n {
+		end += m - 1
+	}
+
+	var (
+		fwd = newMatcher(p.fwd, m, p.mismatches)
+		rev *matcher
+	)
+	if p.rev != nil {
+		rev = newMatcher(p.rev, m, p.mismatches)
+	}
+	var matches []*Match
+	report := func(i, d int, o feat.Orientation) {
+		from := i - m + 1
+		to := from + m
+		if to > n {
+			to -= n
+		}
+		matches = append(matches, &Match{
+			From:       s.Start() + from,
+			To:         s.Start() + to,
+			Loc:        s,
+			Orient:     o,
+			Mismatches: d,
+			Pattern:    p,
+		})
+	}
+	for i := 0; i < end; i++ {
+		l := s.At(s.Start() + i%n).L
+		if d := fwd.next(l); d >= 0 {
+			report(i, d, feat.Forward)
+		}
+		if rev == nil {
+			continue
+		}
+		if d := rev.next(l); d >= 0 {
+			report(i, d, feat.Reverse)
+		}
+	}
+	return matches, nil
+}
+
+// matcher is the Shift-And state for a pattern.
+type matcher struct {
+	masks *[256]uint64
+	r     []uint64
+	match uint64
+}
+
+func newMatcher(masks *[256]uint64, n, mismatches int) *matcher {
+	return &matcher{masks: masks, r: make([]uint64, mismatches+1), match: 1 << uint(n-1)}
+}
+
+// next advances the matcher by the letter l and returns the smallest number of mismatches
+// of a match of the pattern ending at l, or -1 if there is no match.
+func (m *matcher) next(l alphabet.Letter) int {
+	b := m.masks[l]
+	prev := m.r[0]
+	m.r[0] = (prev<<1 | 1) & b
+	for d := 1; d < len(m.r); d++ {
+		cur := m.r[d]
+		m.r[d] = (cur<<1|1)&b | (prev<<1 | 1)
+		prev = cur
+	}
+	for d, r := range m.r {
+		if r&m.match != 0 {
+			return d
+		}
+	}
+	return -1
+}
@@ -0,0 +1,40 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pattern_test
+
+import (
+	"fmt"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/pattern"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+func ExamplePattern_Search() {
+	s := linear.NewSeq("plasmid", alphabet.BytesToLetters([]byte("attcaaggatgcccatcctga")), alphabet.DNA)
+	s.Conform = feat.Circular
+
+	for _, p := range []*pattern.Pattern{
+		pattern.MustCompile("GAATTC", 0), // EcoRI
+		pattern.MustCompile("GGATG", 0),  // FokI
+		pattern.MustCompile("GCNGC", 1),
+	} {
+		m, err := p.Search(s)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, h := range m {
+			fmt.Printf("%s %d-%d %v mismatches=%d\n", h.Name(), h.Start(), h.End(), h.Orientation(), h.Mismatches)
+		}
+	}
+
+	// Output:
+	// GAATTC 19-4 forward mismatches=0
+	// GGATG 6-11 forward mismatches=0
+	// GGATG 13-18 reverse mismatches=0
+	// GCNGC 7-12 forward mismatches=1
+}
@@ -0,0 +1,230 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pattern
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type hit struct {
+	from, to   int
+	orient     feat.Orientation
+	mismatches int
+}
+
+func hits(m []*Match) []hit {
+	var h []hit
+	for _, v := range m {
+		h = append(h, hit{v.From, v.To, v.Orient, v.Mismatches})
+	}
+	return h
+}
+
+func (s *S) TestCompile(c *check.C) {
+	for _, t := range []struct {
+		pattern    string
+		mismatches int
+		err        string
+	}{
+		{pattern: "", err: "pattern: empty pattern"},
+		{pattern: strings.Repeat("a", MaxLen+1), err: "pattern: pattern longer than 64"},
+		{pattern: "ac-gt", err: `pattern: invalid letter '-' in pattern`},
+		{pattern: "acxgt", err: `pattern: invalid letter 'x' in pattern`},
+		{pattern: "acgt", mismatches: 4, err: "pattern: invalid mismatch count 4"},
+		{pattern: "acgt", mismatches: -1, err: "pattern: invalid mismatch count -1"},
+	} {
+		_, err := Compile(t.pattern, t.mismatches)
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+
+	p, err := Compile(strings.Repeat("N", MaxLen), 2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.Len(), check.Equals, MaxLen)
+	c.Check(p.Mismatches(), check.Equals, 2)
+	c.Check(p.rev, check.IsNil)
+	c.Check(MustCompile("GAATTC", 0).rev, check.IsNil)
+	c.Check(MustCompile("GGATG", 0).rev, check.NotNil)
+	c.Check(func() { MustCompile("j", 0) }, check.PanicMatches, `pattern: invalid letter 'j' in pattern`)
+}
+
+func (s *S) TestSearch(c *check.C) {
+	for _, t := range []struct {
+		pattern    string
+		mismatches int
+		seq        string
+		circular   bool
+		want       []hit
+	}{
+		{
+			pattern: "GAATTC",
+			seq:     "ttgaattcaagaattc",
+			want:    []hit{{2, 8, feat.Forward, 0}, {10, 16, feat.Forward, 0}},
+		},
+		{
+			pattern: "GGATG",
+			seq:     "aaggatgcccatcc",
+			want:    []hit{{2, 7, feat.Forward, 0}, {9, 14, feat.Reverse, 0}},
+		},
+		{
+			pattern: "RGATCY",
+			seq:     "AGATCTnggatccnAGATCT",
+			want:    []hit{{0, 6, feat.Forward, 0}, {7, 13, feat.Forward, 0}, {14, 20, feat.Forward, 0}},
+		},
+		{
+			// Ambiguous sequence letters match only pattern
+			// letters representing all of their bases.
+			pattern: "ARGT",
+			seq:     "argtanGtaRgt",
+			want:    []hit{{0, 4, feat.Forward, 0}, {8, 12, feat.Forward, 0}},
+		},
+		{
+			pattern:    "ACGTAC",
+			mismatches: 1,
+			seq:        "acgtacttttacgaac",
+			want:       []hit{{0, 6, feat.Forward, 0}, {2, 8, feat.Reverse, 1}, {10, 16, feat.Forward, 1}},
+		},
+		{
+			pattern:  "GAATTC",
+			seq:      "attcaaggatgcccga",
+			circular: true,
+			want:     []hit{{14, 4, feat.Forward, 0}},
+		},
+		{
+			pattern:  "GAATTC",
+			seq:      "attcaaggatgcccga",
+			circular: false,
+			want:     nil,
+		},
+		{
+			// Matches in a circular sequence no longer than
+			// the pattern do not span the origin.
+			pattern:  "GAATTC",
+			seq:      "ttcgaa",
+			circular: true,
+			want:     nil,
+		},
+		{
+			pattern:  "GAATTC",
+			seq:      "gaattc",
+			circular: true,
+			want:     []hit{{0, 6, feat.Forward, 0}},
+		},
+		{
+			pattern:    "ACGU",
+			mismatches: 1,
+			seq:        "acgacgt",
+			want:       []hit{{0, 4, feat.Forward, 1}, {3, 7, feat.Forward, 0}},
+		},
+	} {
+		sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte(t.seq)), alphabet.DNAredundant)
+		if t.circular {
+			sq.Conform = feat.Circular
+		}
+		m, err := MustCompile(t.pattern, t.mismatches).Search(sq)
+		c.Assert(err, check.Equals, nil)
+		c.Check(hits(m), check.DeepEquals, t.want, check.Commentf("%s in %s", t.pattern, t.seq))
+		for _, v := range m {
+			c.Check(v.Location(), check.Equals, sq)
+			c.Check(v.Name(), check.Equals, t.pattern)
+			c.Check(v.Len(), check.Equals, len(t.pattern))
+		}
+	}
+
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaggatgccc")), alphabet.DNA)
+	sq.Offset = 100
+	m, err := MustCompile("GGATG", 0).Search(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(hits(m), check.DeepEquals, []hit{{102, 107, feat.Forward, 0}})
+
+	_, err = MustCompile("GGATG", 0).Search(linear.NewSeq("test", nil, alphabet.Protein))
+	c.Check(err, check.ErrorMatches, "pattern: sequence is not a nucleic acid")
+}
+
+// naive returns the matches of pattern in s with at most k mismatches by direct
+// comparison of each position.
+func naive(pattern string, k int, s string, circular bool) []hit {
+	n, m := len(s), len(pattern)
+	fwd := make([]uint8, m)
+	rev := make([]uint8, m)
+	for i := range pattern {
+		fwd[i] = iupac[pattern[i]]
+		rev[m-1-i] = complement(fwd[i])
+	}
+	palindrome := string(fwd) == string(rev)
+	last := n - m
+	if circular && m < n {
+		last = n - 1
+	}
+	count := func(p []uint8, from int) int {
+		var d int
+		for i, b := range p {
+			l := iupac[s[(from+i)%n]]
+			if l == 0 || l&^b != 0 {
+				d++
+			}
+		}
+		return d
+	}
+	var h []hit
+	for from := 0; from <= last; from++ {
+		to := from + m
+		if to > n {
+			to -= n
+		}
+		if d := count(fwd, from); d <= k {
+			h = append(h, hit{from, to, feat.Forward, d})
+		}
+		if palindrome {
+			continue
+		}
+		if d := count(rev, from); d <= k {
+			h = append(h, hit{from, to, feat.Reverse, d})
+		}
+	}
+	return h
+}
+
+func (s *S) TestSearchRandom(c *check.C) {
+	const (
+		letters = "acgtacgtacgtrynx-"
+		codes   = "acgtacgtrykmswbdhvn"
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		b := make([]byte, rnd.Intn(40))
+		for j := range b {
+			b[j] = letters[rnd.Intn(len(letters))]
+		}
+		p := make([]byte, 1+rnd.Intn(8))
+		for j := range p {
+			p[j] = codes[rnd.Intn(len(codes))]
+		}
+		k := rnd.Intn(len(p))
+		circular := rnd.Intn(2) == 0
+
+		sq := linear.NewSeq("test", alphabet.BytesToLetters(b), alphabet.DNAredundant)
+		if circular {
+			sq.Conform = feat.Circular
+		}
+		m, err := MustCompile(string(p), k).Search(sq)
+		c.Assert(err, check.Equals, nil)
+		c.Check(hits(m), check.DeepEquals, naive(string(p), k, string(b), circular),
+			check.Commentf("%s with %d mismatches in %s circular=%t", p, k, b, circular))
+	}
+}
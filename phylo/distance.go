@@ -0,0 +1,214 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+// An Alignment is a multiple alignment with named rows, such as a *multi.Multi or an
+// *alignment.Seq.
+type Alignment interface {
+	seq.Aligned
+	seq.Rower
+	Alphabet() alphabet.Alphabet
+}
+
+// Model is an evolutionary distance model.
+type Model int
+
+const (
+	// PDistance is the proportion of compared sites that differ.
+	PDistance Model = iota
+
+	// JukesCantor is the Jukes and Cantor (1969) nucleotide distance.
+	JukesCantor
+
+	// Kimura2P is the Kimura (1980) two-parameter nucleotide
+	// distance allowing for different transition and
+	// transversion rates.
+	Kimura2P
+
+	// TamuraNei is the Tamura and Nei (1993) nucleotide distance
+	// allowing for different purine and pyrimidine transition
+	// rates and unequal base frequencies. Base frequencies are
+	// estimated from the compared sites of all the sequences.
+	TamuraNei
+
+	// Poisson is the Poisson corrected amino acid distance.
+	Poisson
+)
+
+// Deletion specifies how sites with gaps or missing data are treated when calculating
+// distances. Gaps, the ambiguous letter of the alignment alphabet and, for the nucleotide
+// distance models, letters other than a, c, g, t and u are treated as missing data.
+type Deletion int
+
+const (
+	// PairwiseDeletion excludes a site from the comparison of a
+	// pair of sequences when either of the pair has missing data
+	// at the site.
+	PairwiseDeletion Deletion = iota
+
+	// CompleteDeletion excludes a site from all comparisons when
+	// any sequence has missing data at the site.
+	CompleteDeletion
+)
+
+// Distances returns the matrix of distances between the rows of the alignment a under the
+// model m, treating sites with missing data according to del. The names of the taxa in the
+// returned matrix are the names of the rows of a. Distances returns an error if a pair of
+// rows have no sites in common or if the distance between a pair is not defined by the
+// model because the sequences are too divergent.
+func Distances(a Alignment, m Model, del Deletion) (*DistanceMatrix, error) {
+	alpha := a.Alphabet()
+	nucleotide := m == JukesCantor || m == Kimura2P || m == TamuraNei
+	if nucleotide {
+		if t := alpha.Moltype(); t != feat.DNA && t != feat.RNA {
+			return nil, errors.New("phylo: nucleotide distance model used with non-nucleic acid alignment")
+		}
+	} else if m != PDistance && m != Poisson {
+		return nil, fmt.Errorf("phylo: unknown distance model %d", m)
+	}
+
+	// sites holds the letter index of each row at each site,
+	// or -1 for missing data. For nucleotide models, indices
+	// are 0, 1, 2 and 3 for a, c, g and t or u.
+	rows := a.Rows()
+	var sites [][]int
+	for pos := a.Start(); pos < a.End(); pos++ {
+		col := a.Column(pos, true)
+		if len(col) != rows {
+			return nil, fmt.Errorf("phylo: column %d has %d letters for %d rows", pos, len(col), rows)
+		}
+		s := make([]int, rows)
+		var missing bool
+		for i, l := range col {
+			s[i] = siteIndex(alpha, l, nucleotide)
+			missing = missing || s[i] < 0
+		}
+		if del == CompleteDeletion && missing {
+			continue
+		}
+		sites = append(sites, s)
+	}
+
+	var freqs [4]float64
+	if m == TamuraNei {
+		var n float64
+		for _, s := range sites {
+			for _, b := range s {
+				if b >= 0 {
+					freqs[b]++
+					n++
+				}
+			}
+		}
+		for b := range freqs {
+			if freqs[b] == 0 {
+				return nil, errors.New("phylo: Tamura-Nei distance requires all four bases")
+			}
+			freqs[b] /= n
+		}
+	}
+
+	names := make([]string, rows)
+	for i := range names {
+		names[i] = a.Row(i).Name()
+	}
+	d := NewDistanceMatrix(names)
+	for i := 0; i < rows; i++ {
+		for j := i + 1; j < rows; j++ {
+			var n, diff, ag, ct float64
+			for _, s := range sites {
+				x, y := s[i], s[j]
+				if x < 0 || y < 0 {
+					continue
+				}
+				n++
+				if x == y {
+					continue
+				}
+				diff++
+				switch {
+				case nucleotide && x|y == 2: // a and g.
+					ag++
+				case nucleotide && x|y == 3 && x&y == 1: // c and t.
+					ct++
+				}
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("phylo: no sites in common between %q and %q", names[i], names[j])
+			}
+			v := distance(m, n, diff, ag, ct, freqs)
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil, fmt.Errorf("phylo: distance between %q and %q not defined by model", names[i], names[j])
+			}
+			if v == 0 {
+				// Avoid negative zero from the corrections.
+				v = 0
+			}
+			d.Set(i, j, v)
+		}
+	}
+	return d, nil
+}
+
+// siteIndex returns the index used for the letter l at a site, or -1 if l is missing data.
+func siteIndex(alpha alphabet.Alphabet, l alphabet.Letter, nucleotide bool) int {
+	if nucleotide {
+		switch l | ('a' - 'A') {
+		case 'a':
+			return 0
+		case 'c':
+			return 1
+		case 'g':
+			return 2
+		case 't', 'u':
+			return 3
+		}
+		return -1
+	}
+	i := alpha.IndexOf(l)
+	if i < 0 || l == alpha.Gap() || i == alpha.IndexOf(alpha.Ambiguous()) {
+		return -1
+	}
+	return i
+}
+
+// distance returns the distance under model m for n compared sites with diff differences,
+// of which ag are a/g transitions and ct are c/t transitions. The nucleotide frequencies
+// in the order a, c, g, t are given in f. The returned distance is NaN or infinite if it is
+// not defined.
+func distance(m Model, n, diff, ag, ct float64, f [4]float64) float64 {
+	p := diff / n
+	switch m {
+	case PDistance:
+		return p
+	case JukesCantor:
+		return -0.75 * math.Log(1-4*p/3)
+	case Kimura2P:
+		ts := (ag + ct) / n
+		tv := p - ts
+		return -0.5*math.Log(1-2*ts-tv) - 0.25*math.Log(1-2*tv)
+	case TamuraNei:
+		p1, p2 := ag/n, ct/n
+		q := p - p1 - p2
+		a, c, g, t := f[0], f[1], f[2], f[3]
+		r, y := a+g, c+t
+		return -2*a*g/r*math.Log(1-r*p1/(2*a*g)-q/(2*r)) -
+			2*c*t/y*math.Log(1-y*p2/(2*c*t)-q/(2*y)) -
+			2*(r*y-a*g*y/r-c*t*r/y)*math.Log(1-q/(2*r*y))
+	case Poisson:
+		return -math.Log(1 - p)
+	}
+	panic("phylo: unknown distance model")
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/alignment"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+	"gopkg.in/check.v1"
+)
+
+func newMulti(c *check.C, alpha alphabet.Alphabet, rows ...string) *multi.Multi {
+	var sr []seq.Sequence
+	for i, r := range rows {
+		sr = append(sr, linear.NewSeq(fmt.Sprint("s", i), alphabet.BytesToLetters([]byte(r)), alpha))
+	}
+	m, err := multi.NewMulti("test", sr, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestDistances(c *check.C) {
+	// The second row differs from the first by four transitions,
+	// two a/g and two c/t, and two transversions while keeping
+	// equal base frequencies, so the Tamura-Nei distance between
+	// them is equal to the Kimura distance.
+	m := newMulti(c, alphabet.DNAgapped,
+		"acgtacgtacgtacgt",
+		"gtaccagtacgtacgt",
+		"acgt--gtacgtacgn",
+	)
+	const tol = 1e-12
+	for _, t := range []struct {
+		model Model
+		del   Deletion
+		want  [3]float64 // s0/s1, s0/s2 and s1/s2.
+	}{
+		{model: PDistance, del: PairwiseDeletion, want: [3]float64{6.0 / 16, 0, 4.0 / 13}},
+		{model: PDistance, del: CompleteDeletion, want: [3]float64{4.0 / 13, 0, 4.0 / 13}},
+		{model: JukesCantor, del: PairwiseDeletion, want: [3]float64{-0.75 * math.Log(0.5), 0, -0.75 * math.Log(1-16.0/39)}},
+		{model: Kimura2P, del: PairwiseDeletion, want: [3]float64{-0.5*math.Log(0.375) - 0.25*math.Log(0.75), 0, -0.5 * math.Log(1-8.0/13)}},
+		{model: Poisson, del: PairwiseDeletion, want: [3]float64{-math.Log(1 - 6.0/16), 0, -math.Log(1 - 4.0/13)}},
+	} {
+		d, err := Distances(m, t.model, t.del)
+		c.Assert(err, check.Equals, nil)
+		c.Check(d.Names, check.DeepEquals, []string{"s0", "s1", "s2"})
+		for k, p := range [][2]int{{0, 1}, {0, 2}, {1, 2}} {
+			if math.IsNaN(t.want[k]) {
+				continue
+			}
+			c.Check(math.Abs(d.At(p[0], p[1])-t.want[k]) < tol, check.Equals, true,
+				check.Commentf("model %d deletion %d pair %v: got %v want %v", t.model, t.del, p, d.At(p[0], p[1]), t.want[k]))
+			c.Check(d.At(p[1], p[0]), check.Equals, d.At(p[0], p[1]))
+		}
+		c.Check(math.Signbit(d.At(0, 2)), check.Equals, false)
+	}
+	d, err := Distances(newMulti(c, alphabet.DNAgapped, "acgtacgtacgtacgt", "gtaccagtacgtacgt"), TamuraNei, PairwiseDeletion)
+	c.Assert(err, check.Equals, nil)
+	c.Check(math.Abs(d.At(0, 1)-(-0.5*math.Log(0.375)-0.25*math.Log(0.75))) < tol, check.Equals, true)
+
+	// Distances are calculated for alignment.Seq and protein alignments.
+	a, err := alignment.NewSeq("protein", []string{"p0", "p1"}, [][]alphabet.Letter{
+		[]alphabet.Letter("mm"), []alphabet.Letter("kr"), []alphabet.Letter("vv"), []alphabet.Letter("x-"),
+	}, alphabet.Protein, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	d, err = Distances(a, Poisson, PairwiseDeletion)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d.Names, check.DeepEquals, []string{"p0", "p1"})
+	c.Check(math.Abs(d.At(0, 1)-(-math.Log(1-1.0/3))) < tol, check.Equals, true)
+
+	_, err = Distances(a, JukesCantor, PairwiseDeletion)
+	c.Check(err, check.ErrorMatches, "phylo: nucleotide distance model used with non-nucleic acid alignment")
+	_, err = Distances(a, Model(-1), PairwiseDeletion)
+	c.Check(err, check.ErrorMatches, "phylo: unknown distance model -1")
+	_, err = Distances(newMulti(c, alphabet.DNAgapped, "acgt", "cgta"), JukesCantor, PairwiseDeletion)
+	c.Check(err, check.ErrorMatches, `phylo: distance between "s0" and "s1" not defined by model`)
+	_, err = Distances(newMulti(c, alphabet.DNAgapped, "ac--", "--gt"), PDistance, PairwiseDeletion)
+	c.Check(err, check.ErrorMatches, `phylo: no sites in common between "s0" and "s1"`)
+	_, err = Distances(newMulti(c, alphabet.DNAgapped, "aacc", "acac"), TamuraNei, PairwiseDeletion)
+	c.Check(err, check.ErrorMatches, "phylo: Tamura-Nei distance requires all four bases")
+}
@@ -0,0 +1,212 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A NewickReader reads trees in Newick format.
+type NewickReader struct {
+	r *bufio.Reader
+}
+
+// NewNewickReader returns a new NewickReader that reads from r.
+func NewNewickReader(r io.Reader) *NewickReader {
+	return &NewickReader{r: bufio.NewReader(r)}
+}
+
+// Read reads the next tree terminated by a semicolon and returns its root. Comments in square
+// brackets are ignored and underscores in unquoted labels are read as spaces. Leaf nodes are
+// given Taxon indices in order of appearance in the tree and internal nodes are given a Taxon
+// of -1. Missing branch lengths are read as zero. At the end of the input Read returns a nil
+// node and io.EOF.
+func (r *NewickReader) Read() (*Node, error) {
+	var (
+		b       []byte
+		quoted  bool
+		comment bool
+	)
+	for {
+		c, err := r.r.ReadByte()
+		if err != nil {
+			if err == io.EOF && len(bytes.TrimSpace(b)) != 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		switch {
+		case quoted:
+			quoted = c != '\''
+		case comment:
+			comment = c != ']'
+		case c == '\'':
+			quoted = true
+		case c == '[':
+			comment = true
+		case c == ';':
+			p := &newickParser{b: b}
+			n, err := p.node()
+			if err != nil {
+				return nil, err
+			}
+			p.skip()
+			if p.pos != len(p.b) {
+				return nil, p.errorf("unexpected %q", p.b[p.pos])
+			}
+			return n, nil
+		}
+		b = append(b, c)
+	}
+}
+
+type newickParser struct {
+	b    []byte
+	pos  int
+	taxa int
+}
+
+func (p *newickParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("phylo: newick: "+format+" at offset %d", append(args, p.pos)...)
+}
+
+// skip skips white space and comments.
+func (p *newickParser) skip() {
+	for p.pos < len(p.b) {
+		switch c := p.b[p.pos]; {
+		case c == '[':
+			for p.pos < len(p.b) && p.b[p.pos] != ']' {
+				p.pos++
+			}
+			p.pos++
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *newickParser) peek() byte {
+	p.skip()
+	if p.pos < len(p.b) {
+		return p.b[p.pos]
+	}
+	return 0
+}
+
+func (p *newickParser) node() (*Node, error) {
+	n := &Node{Taxon: -1}
+	if p.peek() == '(' {
+		p.pos++
+		for {
+			c, err := p.node()
+			if err != nil {
+				return nil, err
+			}
+			n.Children = append(n.Children, c)
+			if c := p.peek(); c == ',' {
+				p.pos++
+				continue
+			} else if c == ')' {
+				p.pos++
+				break
+			}
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+
+	var err error
+	n.Name, err = p.label()
+	if err != nil {
+		return nil, err
+	}
+	if n.IsLeaf() {
+		n.Taxon = p.taxa
+		p.taxa++
+	}
+	if p.peek() == ':' {
+		p.pos++
+		p.skip()
+		l := p.token()
+		n.Length, err = strconv.ParseFloat(l, 64)
+		if err != nil {
+			return nil, p.errorf("invalid branch length %q", l)
+		}
+	}
+	return n, nil
+}
+
+// label returns the quoted or unquoted label at the current position.
+func (p *newickParser) label() (string, error) {
+	if p.peek() != '\'' {
+		return strings.Replace(p.token(), "_", " ", -1), nil
+	}
+	var l []byte
+	for p.pos++; p.pos < len(p.b); p.pos++ {
+		c := p.b[p.pos]
+		if c != '\'' {
+			l = append(l, c)
+			continue
+		}
+		if p.pos+1 < len(p.b) && p.b[p.pos+1] == '\'' {
+			l = append(l, c)
+			p.pos++
+			continue
+		}
+		p.pos++
+		return string(l), nil
+	}
+	return "", p.errorf("unterminated quoted label")
+}
+
+// token returns the unquoted text at the current position up to the next delimiter.
+func (p *newickParser) token() string {
+	start := p.pos
+	for p.pos < len(p.b) && !strings.ContainsRune(newickDelimiters, rune(p.b[p.pos])) {
+		p.pos++
+	}
+	return string(p.b[start:p.pos])
+}
+
+const newickDelimiters = "()[]':;, \t\n\r"
+
+// WriteNewick writes the tree rooted at n to w in Newick format followed by a semicolon
+// and a newline. Branch lengths are written for all nodes other than the root, which has
+// its branch length written only if it is non-zero. Labels containing Newick delimiters,
+// underscores or white space are quoted.
+func WriteNewick(w io.Writer, n *Node) error {
+	bw := bufio.NewWriter(w)
+	writeNewick(bw, n, true)
+	bw.WriteString(";\n")
+	return bw.Flush()
+}
+
+func writeNewick(w *bufio.Writer, n *Node, root bool) {
+	if !n.IsLeaf() {
+		w.WriteByte('(')
+		for i, c := range n.Children {
+			if i != 0 {
+				w.WriteByte(',')
+			}
+			writeNewick(w, c, false)
+		}
+		w.WriteByte(')')
+	}
+	if strings.ContainsAny(n.Name, newickDelimiters+"_") {
+		w.WriteString("'" + strings.Replace(n.Name, "'", "''", -1) + "'")
+	} else {
+		w.WriteString(n.Name)
+	}
+	if !root || n.Length != 0 {
+		w.WriteByte(':')
+		w.WriteString(strconv.FormatFloat(n.Length, 'g', -1, 64))
+	}
+}
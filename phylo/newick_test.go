@@ -0,0 +1,88 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestNewick(c *check.C) {
+	for _, t := range []struct {
+		in     string
+		format string
+		out    string
+		leaves int
+	}{
+		{
+			in:     "(A:0.1,B:0.2,(C:0.3,D:0.4)E:0.5)F;",
+			format: "(A:0.1,B:0.2,(C:0.3,D:0.4):0.5):0",
+			out:    "(A:0.1,B:0.2,(C:0.3,D:0.4)E:0.5)F;\n",
+			leaves: 4,
+		},
+		{
+			in:     "  ((a,b)[a comment],\n\t'c d'':e',f_g) ; ",
+			format: "((a:0,b:0):0,c d':e:0,f g:0):0",
+			out:    "((a:0,b:0):0,'c d'':e':0,'f g':0);\n",
+			leaves: 4,
+		},
+		{
+			in:     "leaf:2.5;",
+			format: "leaf:2.5",
+			out:    "leaf:2.5;\n",
+			leaves: 1,
+		},
+	} {
+		r := NewNewickReader(strings.NewReader(t.in))
+		n, err := r.Read()
+		c.Assert(err, check.Equals, nil, check.Commentf("%q", t.in))
+		c.Check(format(n), check.Equals, t.format)
+		leaves := n.Leaves()
+		c.Check(len(leaves), check.Equals, t.leaves)
+		for i, l := range leaves {
+			c.Check(l.Taxon, check.Equals, i)
+		}
+		if !n.IsLeaf() {
+			c.Check(n.Taxon, check.Equals, -1)
+		}
+		_, err = r.Read()
+		c.Check(err, check.Equals, io.EOF)
+
+		var buf bytes.Buffer
+		c.Check(WriteNewick(&buf, n), check.Equals, nil)
+		c.Check(buf.String(), check.Equals, t.out)
+
+		// Written trees read back identically.
+		rn, err := NewNewickReader(&buf).Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(rn, check.DeepEquals, n)
+	}
+
+	r := NewNewickReader(strings.NewReader("(a,b);\n(c,(d,e));\n"))
+	for _, want := range []int{2, 3} {
+		n, err := r.Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(len(n.Children), check.Equals, 2)
+		c.Check(len(n.Leaves()), check.Equals, want)
+	}
+	_, err := r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	for _, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: "(a,b)", err: "unexpected EOF"},
+		{in: "(a,b;", err: "phylo: newick: expected ',' or '\\)' at offset 4"},
+		{in: "(a:x,b);", err: `phylo: newick: invalid branch length "x" at offset 4`},
+		{in: "(a,b)c d;", err: `phylo: newick: unexpected 'd' at offset 7`},
+	} {
+		_, err := NewNewickReader(strings.NewReader(t.in)).Read()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("%q", t.in))
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phylo provides evolutionary distance calculation, distance-based phylogenetic
+// tree construction and reading and writing of trees in Newick format.
+package phylo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A DistanceMatrix is a symmetric matrix of pairwise distances between named taxa.
+type DistanceMatrix struct {
+	// Names holds the names of the taxa.
+	Names []string
+
+	// Dist holds the distances between taxa, indexed
+	// by the positions of the taxa in Names.
+	Dist [][]float64
+}
+
+// NewDistanceMatrix returns a new DistanceMatrix for taxa with the given names. All distances
+// are initially zero.
+func NewDistanceMatrix(names []string) *DistanceMatrix {
+	d := make([][]float64, len(names))
+	for i := range d {
+		d[i] = make([]float64, len(names))
+	}
+	return &DistanceMatrix{Names: names, Dist: d}
+}
+
+// Len returns the number of taxa in the matrix.
+func (d *DistanceMatrix) Len() int { return len(d.Dist) }
+
+// At returns the distance between taxa i and j.
+func (d *DistanceMatrix) At(i, j int) float64 { return d.Dist[i][j] }
+
+// Set sets the distance between taxa i and j, and between j and i, to v.
+func (d *DistanceMatrix) Set(i, j int, v float64) { d.Dist[i][j], d.Dist[j][i] = v, v }
+
+// check returns an error if d is not a valid non-empty distance matrix.
+func (d *DistanceMatrix) check() error {
+	if d.Len() == 0 {
+		return errors.New("phylo: empty distance matrix")
+	}
+	if len(d.Names) != d.Len() {
+		return fmt.Errorf("phylo: %d names for %d taxa", len(d.Names), d.Len())
+	}
+	for _, row := range d.Dist {
+		if len(row) != d.Len() {
+			return errors.New("phylo: distance matrix is not square")
+		}
+	}
+	for i, row := range d.Dist {
+		for j, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return fmt.Errorf("phylo: invalid distance %v between %q and %q", v, d.Names[i], d.Names[j])
+			}
+			if v != d.Dist[j][i] {
+				return fmt.Errorf("phylo: distance matrix is not symmetric at %q and %q", d.Names[i], d.Names[j])
+			}
+		}
+	}
+	return nil
+}
+
+// A Node is a node of a phylogenetic tree. A tree is represented by its root node.
+type Node struct {
+	// Name is the name of the node.
+	Name string
+
+	// Taxon is the index of the taxon represented by
+	// a leaf node in the distance matrix the tree was
+	// built from. It is -1 for internal nodes.
+	Taxon int
+
+	// Length is the length of the branch
+	// from the node to its parent.
+	Length float64
+
+	// Children holds the child nodes of the node.
+	Children []*Node
+}
+
+// IsLeaf returns whether n has no children.
+func (n *Node) IsLeaf() bool { return len(n.Children) == 0 }
+
+// Leaves returns the leaves of the tree rooted at n in depth-first order.
+func (n *Node) Leaves() []*Node {
+	if n.IsLeaf() {
+		return []*Node{n}
+	}
+	var l []*Node
+	for _, c := range n.Children {
+		l = append(l, c.Leaves()...)
+	}
+	return l
+}
+
+// leaves returns a leaf node for each taxon of d.
+func leaves(d *DistanceMatrix) []*Node {
+	n := make([]*Node, d.Len())
+	for i, name := range d.Names {
+		n[i] = &Node{Name: name, Taxon: i}
+	}
+	return n
+}
+
+// UPGMA returns a rooted ultrametric tree of the taxa in d constructed by the unweighted
+// pair group method with arithmetic mean. Ties between equally close pairs of clusters are
+// broken in favour of the pair with the lowest indices.
+func UPGMA(d *DistanceMatrix) (*Node, error) {
+	err := d.check()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nodes  = leaves(d)
+		size   = make([]int, len(nodes))
+		height = make([]float64, len(nodes))
+		dist   = clone(d.Dist)
+		active = len(nodes)
+	)
+	for i := range size {
+		size[i] = 1
+	}
+	for active > 1 {
+		bi, bj := -1, -1
+		for i := range nodes {
+			if nodes[i] == nil {
+				continue
+			}
+			for j := i + 1; j < len(nodes); j++ {
+				if nodes[j] != nil && (bi < 0 || dist[i][j] < dist[bi][bj]) {
+					bi, bj = i, j
+				}
+			}
+		}
+
+		h := dist[bi][bj] / 2
+		nodes[bi].Length = h - height[bi]
+		nodes[bj].Length = h - height[bj]
+		nodes[bi] = &Node{Taxon: -1, Children: []*Node{nodes[bi], nodes[bj]}}
+		for k := range nodes {
+			if nodes[k] == nil || k == bi || k == bj {
+				continue
+			}
+			v := (float64(size[bi])*dist[bi][k] + float64(size[bj])*dist[bj][k]) / float64(size[bi]+size[bj])
+			dist[bi][k], dist[k][bi] = v, v
+		}
+		size[bi] += size[bj]
+		height[bi] = h
+		nodes[bj] = nil
+		active--
+	}
+	return nodes[0], nil
+}
+
+// NeighborJoining returns an unrooted tree of the taxa in d constructed by the neighbour
+// joining method of Saitou and Nei. The tree is rooted at the final join, which has three
+// children when d holds more than two taxa. Branch lengths are not constrained to be
+// non-negative. Ties between equally close pairs of nodes are broken in favour of the pair
+// with the lowest indices.
+func NeighborJoining(d *DistanceMatrix) (*Node, error) {
+	err := d.check()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nodes  = leaves(d)
+		dist   = clone(d.Dist)
+		active = len(nodes)
+	)
+	switch active {
+	case 1:
+		return nodes[0], nil
+	case 2:
+		nodes[0].Length = dist[0][1] / 2
+		nodes[1].Length = dist[0][1] / 2
+		return &Node{Taxon: -1, Children: nodes}, nil
+	}
+
+	r := make([]float64, len(nodes))
+	for active > 3 {
+		for i := range nodes {
+			if nodes[i] == nil {
+				continue
+			}
+			r[i] = 0
+			for k := range nodes {
+				if nodes[k] != nil {
+					r[i] += dist[i][k]
+				}
+			}
+		}
+
+		var (
+			bi, bj = -1, -1
+			best   float64
+		)
+		for i := range nodes {
+			if nodes[i] == nil {
+				continue
+			}
+			for j := i + 1; j < len(nodes); j++ {
+				if nodes[j] == nil {
+					continue
+				}
+				q := float64(active-2)*dist[i][j] - r[i] - r[j]
+				if bi < 0 || q < best {
+					bi, bj, best = i, j, q
+				}
+			}
+		}
+
+		li := dist[bi][bj]/2 + (r[bi]-r[bj])/float64(2*(active-2))
+		nodes[bi].Length = li
+		nodes[bj].Length = dist[bi][bj] - li
+		for k := range nodes {
+			if nodes[k] == nil || k == bi || k == bj {
+				continue
+			}
+			v := (dist[bi][k] + dist[bj][k] - dist[bi][bj]) / 2
+			dist[bi][k], dist[k][bi] = v, v
+		}
+		nodes[bi] = &Node{Taxon: -1, Children: []*Node{nodes[bi], nodes[bj]}}
+		nodes[bj] = nil
+		active--
+	}
+
+	var idx []int
+	for i, n := range nodes {
+		if n != nil {
+			idx = append(idx, i)
+		}
+	}
+	i, j, k := idx[0], idx[1], idx[2]
+	nodes[i].Length = (dist[i][j] + dist[i][k] - dist[j][k]) / 2
+	nodes[j].Length = dist[i][j] - nodes[i].Length
+	nodes[k].Length = dist[i][k] - nodes[i].Length
+	return &Node{Taxon: -1, Children: []*Node{nodes[i], nodes[j], nodes[k]}}, nil
+}
+
+func clone(d [][]float64) [][]float64 {
+	c := make([][]float64, len(d))
+	for i, row := range d {
+		c[i] = append([]float64(nil), row...)
+	}
+	return c
+}
@@ -0,0 +1,54 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo_test
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/phylo"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"fmt"
+	"os"
+)
+
+func Example() {
+	// The sequences differ by substitutions at distinct sites
+	// along the branches of a tree, so the p-distances between
+	// them are additive and neighbour joining recovers the tree.
+	var rows []seq.Sequence
+	for _, s := range []struct{ id, seq string }{
+		{"human", "gcacacgtacgtacgtacgtacgtacgtacgt"},
+		{"chimp", "atacacgtacgtacgtacgtacgtacgtacgt"},
+		{"mouse", "acgtgtgcgtgtacgtacgtacgtacgtacgt"},
+		{"rat", "acgtacacgtgtacgtacgtacgtacgtacgt"},
+		{"chicken", "acgtacgtacacgtacacgtacgtacgtacgt"},
+	} {
+		rows = append(rows, linear.NewSeq(s.id, alphabet.BytesToLetters([]byte(s.seq)), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("example", rows, seq.DefaultConsensus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	d, err := phylo.Distances(m, phylo.PDistance, phylo.PairwiseDeletion)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	t, err := phylo.NeighborJoining(d)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	err = phylo.WriteNewick(os.Stdout, t)
+	if err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// ((human:0.03125,chimp:0.03125):0.0625,(mouse:0.0625,rat:0.03125):0.09375,chicken:0.1875);
+}
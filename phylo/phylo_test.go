@@ -0,0 +1,134 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func distances(names string, d ...float64) *DistanceMatrix {
+	m := NewDistanceMatrix(strings.Split(names, ""))
+	var k int
+	for i := 0; i < m.Len(); i++ {
+		for j := i + 1; j < m.Len(); j++ {
+			m.Set(i, j, d[k])
+			k++
+		}
+	}
+	return m
+}
+
+// format returns a parenthesised representation of the tree rooted at n with
+// branch lengths.
+func format(n *Node) string {
+	if n.IsLeaf() {
+		return fmt.Sprintf("%s:%g", n.Name, n.Length)
+	}
+	c := make([]string, len(n.Children))
+	for i, ch := range n.Children {
+		c[i] = format(ch)
+	}
+	return fmt.Sprintf("(%s):%g", strings.Join(c, ","), n.Length)
+}
+
+// pathLengths returns the path lengths between the leaves of the tree rooted at n
+// indexed by leaf Taxon.
+func pathLengths(n *Node, taxa int) [][]float64 {
+	d := make([][]float64, taxa)
+	for i := range d {
+		d[i] = make([]float64, taxa)
+	}
+	var walk func(n *Node) map[int]float64
+	walk = func(n *Node) map[int]float64 {
+		if n.IsLeaf() {
+			return map[int]float64{n.Taxon: n.Length}
+		}
+		var below []map[int]float64
+		for _, c := range n.Children {
+			below = append(below, walk(c))
+		}
+		for i, a := range below {
+			for _, b := range below[i+1:] {
+				for ta, la := range a {
+					for tb, lb := range b {
+						d[ta][tb] = la + lb
+						d[tb][ta] = la + lb
+					}
+				}
+			}
+		}
+		up := make(map[int]float64)
+		for _, m := range below {
+			for t, l := range m {
+				up[t] = l + n.Length
+			}
+		}
+		return up
+	}
+	walk(n)
+	return d
+}
+
+func (s *S) TestUPGMA(c *check.C) {
+	d := distances("abcde", 17, 21, 31, 23, 30, 34, 21, 28, 39, 43)
+	t, err := UPGMA(d)
+	c.Assert(err, check.Equals, nil)
+	c.Check(format(t), check.Equals, "(((a:8.5,b:8.5):2.5,e:11):5.5,(c:14,d:14):2.5):0")
+	c.Check(len(t.Leaves()), check.Equals, 5)
+
+	// An ultrametric matrix is recovered exactly.
+	u := distances("abcd", 2, 6, 6, 6, 6, 4)
+	t, err = UPGMA(u)
+	c.Assert(err, check.Equals, nil)
+	c.Check(pathLengths(t, 4), check.DeepEquals, u.Dist)
+
+	t, err = UPGMA(distances("a"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(format(t), check.Equals, "a:0")
+}
+
+func (s *S) TestNeighborJoining(c *check.C) {
+	d := distances("abcde", 5, 9, 9, 8, 10, 10, 9, 8, 7, 3)
+	t, err := NeighborJoining(d)
+	c.Assert(err, check.Equals, nil)
+	c.Check(format(t), check.Equals, "(((a:2,b:3):3,c:4):2,d:2,e:1):0")
+
+	// An additive matrix is recovered exactly.
+	c.Check(pathLengths(t, 5), check.DeepEquals, d.Dist)
+
+	t, err = NeighborJoining(distances("ab", 4))
+	c.Assert(err, check.Equals, nil)
+	c.Check(format(t), check.Equals, "(a:2,b:2):0")
+}
+
+func (s *S) TestDistanceMatrixErrors(c *check.C) {
+	for _, t := range []struct {
+		d   *DistanceMatrix
+		err string
+	}{
+		{d: &DistanceMatrix{}, err: "phylo: empty distance matrix"},
+		{d: &DistanceMatrix{Names: []string{"a"}, Dist: [][]float64{{0}, {0}}}, err: "phylo: 1 names for 2 taxa"},
+		{d: &DistanceMatrix{Names: []string{"a", "b"}, Dist: [][]float64{{0, 1}, {0}}}, err: "phylo: distance matrix is not square"},
+		{d: &DistanceMatrix{Names: []string{"a", "b"}, Dist: [][]float64{{0, 1}, {2, 0}}}, err: `phylo: distance matrix is not symmetric at "a" and "b"`},
+		{d: distances("ab", math.NaN()), err: `phylo: invalid distance NaN between "a" and "b"`},
+	} {
+		_, err := UPGMA(t.d)
+		c.Check(err, check.ErrorMatches, t.err)
+		_, err = NeighborJoining(t.d)
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
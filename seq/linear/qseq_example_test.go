@@ -53,3 +53,23 @@ func ExampleQSeq_AppendQLetters() {
 	// Reverse:
 	// nnnnAGAGAAGGAnAGGTAGGTGGAGGGAAAAAAATGGTGAATGGATTAAAAGATGAAAAGGATATAGAAGAAAn
 }
+
+func ExampleQSeq_Translate() {
+	l := []alphabet.Letter("GGNTTRATGCGA")
+	q := []alphabet.Qphred{40, 40, 0, 40, 40, 30, 40, 40, 40, 2, 40, 40}
+	s := NewQSeq("example DNA", nil, alphabet.DNAredundant, alphabet.Sanger)
+	for i := range l {
+		s.AppendQLetters(alphabet.QLetter{L: l[i], Q: q[i]})
+	}
+
+	// The low quality C is filtered to an N, so the last
+	// codon does not have an unambiguous translation.
+	p, err := s.Translate(alphabet.Standard, 1, false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s\n", p)
+	// Output:
+	// glmx
+}
@@ -148,3 +148,29 @@ func ExampleSeq_compose() {
 	// aAGTATAAgtcagtgcagtgtctggcag<TS>gtagtgaagtagggttagttta
 	// aAGTATAAgtcagtgcagtgtctggcag<TS>TTATACT<TS>gtagtgaagtagggttagttt
 }
+
+func ExampleSeq_Translate() {
+	s := NewSeq("example DNA", []alphabet.Letter("ATGGCCATTGTAATGGGCCGCTGAAAGGGTGCCCGATAG"), alphabet.DNA)
+	for _, frame := range []int{1, 2, 3, -1, -2, -3} {
+		p, err := s.Translate(alphabet.Standard, frame, false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%2d %-s\n", frame, p)
+	}
+	p, err := s.Translate(alphabet.Standard, 1, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s %v\n", p, p.Moltype())
+	// Output:
+	//  1 maivmgr*kgar*
+	//  2 wpl*waaervpd
+	//  3 ghcngplkgcpi
+	// -1 lsgtlsaahyngh
+	// -2 yrapfqrpitma
+	// -3 ighpfsgplqwp
+	// maivmgr Protein
+}
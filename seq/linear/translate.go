@@ -0,0 +1,78 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linear
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+)
+
+// Translate returns the protein translation of the sequence in the given reading frame
+// using the genetic code gc. Frames 1, 2 and 3 are read from the first, second and third
+// letter of the sequence and frames -1, -2 and -3 are read from the first, second and
+// third letter of the reverse complement of the sequence. Incomplete trailing codons are
+// not translated. Codons are translated by gc.TranslateBases, so codons with redundant
+// bases are translated where the redundancy does not change the amino acid, and stop
+// codons are translated to '*'. If toStop is true, translation ends before the first stop
+// codon. The returned sequence has the ID and description of the receiver and the
+// alphabet.Protein alphabet.
+func (s *Seq) Translate(gc *alphabet.GeneticCode, frame int, toStop bool) (*Seq, error) {
+	return translate(s.ID, s.Desc, s.Alpha, s.Seq, gc, frame, toStop)
+}
+
+// Translate returns the protein translation of the sequence in the given reading frame
+// using the genetic code gc. Letters are filtered by the sequence's QFilter before
+// translation. Reading frames and the handling of codons are as described for the
+// Translate method of Seq.
+func (s *QSeq) Translate(gc *alphabet.GeneticCode, frame int, toStop bool) (*Seq, error) {
+	l := make(alphabet.Letters, len(s.Seq))
+	for i, ql := range s.Seq {
+		l[i] = s.QFilter(s.Alpha, s.Threshold, ql)
+	}
+	return translate(s.ID, s.Desc, s.Alpha, l, gc, frame, toStop)
+}
+
+func translate(id, desc string, alpha alphabet.Alphabet, l alphabet.Letters, gc *alphabet.GeneticCode, frame int, toStop bool) (*Seq, error) {
+	if m := alpha.Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("linear: cannot translate non-nucleic acid sequence")
+	}
+	if frame == 0 || frame < -3 || frame > 3 {
+		return nil, fmt.Errorf("linear: invalid reading frame %d", frame)
+	}
+	if frame < 0 {
+		c, ok := alpha.(alphabet.Complementor)
+		if !ok {
+			return nil, errors.New("linear: cannot reverse complement sequence")
+		}
+		comp := c.ComplementTable()
+		rc := make(alphabet.Letters, len(l))
+		for i, b := range l {
+			rc[len(l)-1-i] = comp[b]
+		}
+		l = rc
+		frame = -frame
+	}
+
+	if frame-1 < len(l) {
+		l = l[frame-1:]
+	} else {
+		l = nil
+	}
+	p := make(alphabet.Letters, 0, len(l)/3)
+	for i := 0; i+3 <= len(l); i += 3 {
+		aa := gc.TranslateBases(l[i], l[i+1], l[i+2])
+		if toStop && aa == '*' {
+			break
+		}
+		p = append(p, aa)
+	}
+	t := NewSeq(id, nil, alphabet.Protein)
+	t.Seq = p
+	t.Desc = desc
+	return t, nil
+}
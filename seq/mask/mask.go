@@ -0,0 +1,253 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mask provides handling of masked regions of sequences.
+//
+// Genome sequences conventionally mark repeats by soft masking, holding the letters of
+// masked regions in lower case. The functions in this package find soft-masked regions,
+// apply soft or hard masks to sequences and carry masks through the coordinate changes
+// made by reverse complementation and the sequtils Truncate and Stitch functions. The
+// latter allows a mask to be kept with sequence types that do not retain letter case.
+package mask
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"sort"
+)
+
+// A Region is a masked region of a sequence.
+type Region struct {
+	// From and To are the start and end of the region.
+	From, To int
+
+	// Loc is the masked sequence.
+	Loc feat.Feature
+}
+
+func (r *Region) Start() int             { return r.From }
+func (r *Region) End() int               { return r.To }
+func (r *Region) Len() int               { return r.To - r.From }
+func (r *Region) Name() string           { return "mask" }
+func (r *Region) Description() string    { return "masked region" }
+func (r *Region) Location() feat.Feature { return r.Loc }
+
+// A Mask is a set of masked regions. Masks returned by functions and methods in this
+// package are sorted by position and hold neither overlapping nor abutting regions.
+type Mask []*Region
+
+// Features returns the regions of the mask as a []feat.Feature. Mask satisfies the
+// feat.Set interface.
+func (m Mask) Features() []feat.Feature {
+	f := make([]feat.Feature, len(m))
+	for i, r := range m {
+		f[i] = r
+	}
+	return f
+}
+
+// isLower returns whether l is a lower case letter.
+func isLower(l alphabet.Letter) bool { return 'a' <= l && l <= 'z' }
+
+// isUpper returns whether l is an upper case letter.
+func isUpper(l alphabet.Letter) bool { return 'A' <= l && l <= 'Z' }
+
+// Find returns the runs of lower case letters in s as a Mask.
+func Find(s seq.Sequence) Mask {
+	var (
+		m     Mask
+		start = -1
+	)
+	for i := s.Start(); i < s.End(); i++ {
+		if isLower(s.At(i).L) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			m = append(m, &Region{From: start, To: i, Loc: s})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		m = append(m, &Region{From: start, To: s.End(), Loc: s})
+	}
+	return m
+}
+
+// Soft soft masks the regions of s covered by the features in fs, converting letters to
+// lower case. Feature positions outside s are ignored.
+func Soft(s seq.Sequence, fs feat.Set) error {
+	return apply(s, fs, func(l alphabet.Letter) alphabet.Letter {
+		if isUpper(l) {
+			return l | ('a' - 'A')
+		}
+		return l
+	})
+}
+
+// Hard hard masks the regions of s covered by the features in fs, replacing letters other
+// than gaps with the upper case form of the ambiguous letter of the alphabet of s, N for
+// nucleic acid alphabets. Feature positions outside s are ignored.
+func Hard(s seq.Sequence, fs feat.Set) error {
+	a := s.Alphabet()
+	amb := a.Ambiguous()
+	if isLower(amb) {
+		amb &^= 'a' - 'A'
+	}
+	return apply(s, fs, func(l alphabet.Letter) alphabet.Letter {
+		if l == a.Gap() {
+			return l
+		}
+		return amb
+	})
+}
+
+func apply(s seq.Sequence, fs feat.Set, fn func(alphabet.Letter) alphabet.Letter) error {
+	ff := fs.Features()
+	for _, f := range ff {
+		if f.End() < f.Start() {
+			return errors.New("mask: feature end < feature start")
+		}
+	}
+	for _, f := range ff {
+		for i := max(f.Start(), s.Start()); i < min(f.End(), s.End()); i++ {
+			ql := s.At(i)
+			if l := fn(ql.L); l != ql.L {
+				ql.L = l
+				err := s.Set(i, ql)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RevComp returns the mask for the reverse complement of the sequence s masked by m.
+// The regions of the returned mask are located on s.
+func (m Mask) RevComp(s feat.Feature) Mask {
+	var (
+		start, end = s.Start(), s.End()
+		rc         = make(Mask, 0, len(m))
+	)
+	for _, r := range m {
+		rc = append(rc, &Region{From: start + end - r.To, To: start + end - r.From, Loc: s})
+	}
+	return rc.normalise()
+}
+
+// Truncate returns the mask for the sequence placed in dst by a call to
+// sequtils.Truncate(dst, src, start, end) where src is masked by m. The regions of the
+// returned mask are located on dst.
+func (m Mask) Truncate(dst, src feat.Feature, start, end int) (Mask, error) {
+	offset := src.Start()
+	if start < offset || end > src.End() {
+		return nil, errors.New("mask: index out of range")
+	}
+	var t Mask
+	if start <= end {
+		t = m.clip(dst, start, end, 0)
+		return t.normalise(), nil
+	}
+
+	if src, ok := src.(seq.Conformationer); !ok || src.Conformation() == feat.Linear {
+		return nil, errors.New("mask: start position greater than end position for linear sequence")
+	}
+	if end < offset || start > src.End() {
+		return nil, errors.New("mask: index out of range")
+	}
+	t = m.clip(dst, start, src.End(), 0)
+	t = append(t, m.clip(dst, offset, end, src.End()-offset)...)
+	return t.normalise(), nil
+}
+
+// Stitch returns the mask for the sequence placed in dst by a call to
+// sequtils.Stitch(dst, src, fs) where src is masked by m. The regions of the returned
+// mask are located on dst.
+func (m Mask) Stitch(dst, src feat.Feature, fs feat.Set) (Mask, error) {
+	ff := fs.Features()
+	for _, f := range ff {
+		if f.End() < f.Start() {
+			return nil, errors.New("mask: feature end < feature start")
+		}
+	}
+	ff = append([]feat.Feature(nil), ff...)
+	sort.Slice(ff, func(i, j int) bool { return ff[i].Start() < ff[j].Start() })
+
+	// Merge the features as is done by sequtils.Stitch.
+	type interval struct{ s, e int }
+	var spans []interval
+	for i, f := range ff {
+		if s := f.Start(); i == 0 || s > spans[len(spans)-1].e {
+			spans = append(spans, interval{s: s, e: f.End()})
+		} else {
+			spans[len(spans)-1].e = max(spans[len(spans)-1].e, f.End())
+		}
+	}
+
+	var (
+		t   Mask
+		pos int
+	)
+	for _, f := range spans {
+		s, e := max(f.s, src.Start()), min(f.e, src.End())
+		if s >= e {
+			continue
+		}
+		t = append(t, m.clip(dst, s, e, pos-s)...)
+		pos += e - s
+	}
+	return t.normalise(), nil
+}
+
+// clip returns the parts of the regions of m within [start, end) shifted by delta and
+// located on loc.
+func (m Mask) clip(loc feat.Feature, start, end, delta int) Mask {
+	var c Mask
+	for _, r := range m {
+		s, e := max(r.From, start), min(r.To, end)
+		if s < e {
+			c = append(c, &Region{From: s + delta, To: e + delta, Loc: loc})
+		}
+	}
+	return c
+}
+
+// normalise sorts the regions of m and merges overlapping and abutting regions.
+func (m Mask) normalise() Mask {
+	if len(m) == 0 {
+		return m
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i].From < m[j].From })
+	n := Mask{m[0]}
+	for _, r := range m[1:] {
+		last := n[len(n)-1]
+		if r.From <= last.To {
+			last.To = max(last.To, r.To)
+			continue
+		}
+		n = append(n, r)
+	}
+	return n
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
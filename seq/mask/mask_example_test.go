@@ -0,0 +1,52 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mask_test
+
+import (
+	"fmt"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/mask"
+	"github.com/biogo/biogo/seq/packed"
+	"github.com/biogo/biogo/seq/sequtils"
+)
+
+func Example() {
+	s := linear.NewSeq("chr", alphabet.BytesToLetters([]byte("ACGTacgtacGTTTGGccaAAC")), alphabet.DNA)
+	m := mask.Find(s)
+	for _, r := range m {
+		fmt.Printf("%d-%d\n", r.Start(), r.End())
+	}
+
+	// Packed sequences do not retain letter case, so
+	// carry the mask through the truncation and apply
+	// it when the sequence is unpacked.
+	p := packed.TwoBitOf(s)
+	err := sequtils.Truncate(p, p, 8, 20)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m, err = m.Truncate(p, s, 8, 20)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	u := p.Linear()
+	fmt.Printf("%-s\n", u)
+	err = mask.Hard(u, m)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%-s\n", u)
+
+	// Output:
+	// 4-10
+	// 16-19
+	// acgtttggccaa
+	// NNgtttggNNNa
+}
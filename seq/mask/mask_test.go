@@ -0,0 +1,138 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mask
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/sequtils"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type span struct{ from, to int }
+
+func spans(m Mask) []span {
+	var s []span
+	for _, r := range m {
+		s = append(s, span{r.From, r.To})
+	}
+	return s
+}
+
+func (s *S) TestFind(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("acGTNnnAC-gtaC")), alphabet.DNAgapped)
+	sq.Offset = 10
+	m := Find(sq)
+	c.Check(spans(m), check.DeepEquals, []span{{10, 12}, {15, 17}, {20, 23}})
+	for _, r := range m {
+		c.Check(r.Location(), check.Equals, sq)
+	}
+	c.Check(len(m.Features()), check.Equals, 3)
+
+	c.Check(Find(linear.NewSeq("test", alphabet.BytesToLetters([]byte("ACGT")), alphabet.DNA)), check.IsNil)
+}
+
+func (s *S) TestSoftHard(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("ACGTAC-GTACGT")), alphabet.DNAgapped)
+	sq.Offset = 5
+	fs := Mask{{From: 3, To: 7}, {From: 10, To: 13}, {From: 16, To: 20}}
+	c.Check(Soft(sq, fs), check.Equals, nil)
+	c.Check(sq.String(), check.Equals, "acGTAc-gTACgt")
+	c.Check(spans(Find(sq)), check.DeepEquals, []span{{5, 7}, {10, 11}, {12, 13}, {16, 18}})
+
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("ACGTAC-GTACGT")), alphabet.DNAgapped)
+	c.Check(Hard(sq, Mask{{From: 4, To: 9}}), check.Equals, nil)
+	c.Check(sq.String(), check.Equals, "ACGTNN-NNACGT")
+
+	p := linear.NewSeq("test", alphabet.BytesToLetters([]byte("MKVL")), alphabet.Protein)
+	c.Check(Hard(p, Mask{{From: 1, To: 3}}), check.Equals, nil)
+	c.Check(p.String(), check.Equals, "MXXL")
+
+	c.Check(Soft(sq, Mask{{From: 4, To: 3}}), check.ErrorMatches, "mask: feature end < feature start")
+}
+
+func (s *S) TestRevComp(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("acGTNnnAC-gtaCG")), alphabet.DNAgapped)
+	sq.Offset = 3
+	m := Find(sq)
+	sq.RevComp()
+	rc := m.RevComp(sq)
+	c.Check(spans(rc), check.DeepEquals, spans(Find(sq)))
+	c.Check(spans(rc), check.DeepEquals, []span{{5, 8}, {11, 13}, {16, 18}})
+	for _, r := range rc {
+		c.Check(r.Location(), check.Equals, sq)
+	}
+}
+
+func randomMasked(rnd *rand.Rand, n int) []alphabet.Letter {
+	b := make([]alphabet.Letter, n)
+	lower := rnd.Intn(2) == 0
+	for i := range b {
+		if rnd.Intn(4) == 0 {
+			lower = !lower
+		}
+		b[i] = alphabet.Letter("ACGT"[rnd.Intn(4)])
+		if lower {
+			b[i] |= 'a' - 'A'
+		}
+	}
+	return b
+}
+
+type features []feat.Feature
+
+func (f features) Features() []feat.Feature { return f }
+
+func (s *S) TestTruncateStitch(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		n := 1 + rnd.Intn(30)
+		sq := linear.NewSeq("test", randomMasked(rnd, n), alphabet.DNA)
+		sq.Offset = 7
+		sq.Conform = feat.Circular
+		m := Find(sq)
+
+		start, end := 7+rnd.Intn(n+1), 7+rnd.Intn(n+1)
+		dst := sq.New().(*linear.Seq)
+		c.Assert(sequtils.Truncate(dst, sq, start, end), check.Equals, nil)
+		t, err := m.Truncate(dst, sq, start, end)
+		c.Assert(err, check.Equals, nil)
+		c.Check(spans(t), check.DeepEquals, spans(Find(dst)), check.Commentf("truncate %s %d-%d", sq, start, end))
+		for _, r := range t {
+			c.Check(r.Location(), check.Equals, dst)
+		}
+
+		var fs features
+		for k := rnd.Intn(4); k >= 0; k-- {
+			s := rnd.Intn(n + 4)
+			fs = append(fs, &Region{From: 5 + s, To: 5 + s + rnd.Intn(n-s+6)})
+		}
+		sq.Conform = feat.Linear
+		dst = sq.New().(*linear.Seq)
+		c.Assert(sequtils.Stitch(dst, sq, fs), check.Equals, nil)
+		t, err = m.Stitch(dst, sq, fs)
+		c.Assert(err, check.Equals, nil)
+		c.Check(spans(t), check.DeepEquals, spans(Find(dst)), check.Commentf("stitch %s", sq))
+	}
+
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("acgt")), alphabet.DNA)
+	_, err := Find(sq).Truncate(sq.New(), sq, 3, 1)
+	c.Check(err, check.ErrorMatches, "mask: start position greater than end position for linear sequence")
+	_, err = Find(sq).Truncate(sq.New(), sq, 0, 5)
+	c.Check(err, check.ErrorMatches, "mask: index out of range")
+	_, err = Find(sq).Stitch(sq.New(), sq, Mask{{From: 3, To: 1}})
+	c.Check(err, check.ErrorMatches, "mask: feature end < feature start")
+}
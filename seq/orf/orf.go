@@ -0,0 +1,294 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package orf provides open reading frame finding for nucleic acid sequences.
+package orf
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/gene"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// An ORF is an open reading frame of a nucleic acid sequence, running from a start codon
+// to and including a stop codon. ORF coordinates are relative to the forward strand of the
+// sequence for ORFs in either orientation. ORFs of circular sequences that span the origin
+// have a From position greater than their To position, as used by sequtils.Truncate.
+type ORF struct {
+	// ID is the name of the ORF.
+	ID string
+
+	// Loc is the sequence holding the ORF.
+	Loc feat.Feature
+
+	// From and To are the start and end of the ORF.
+	From, To int
+
+	// Orient is the orientation of the ORF relative
+	// to the sequence.
+	Orient feat.Orientation
+
+	// Frame is the reading frame of the ORF in the
+	// numbering used by linear.Seq's Translate method.
+	Frame int
+
+	// Partial is true if the ORF is not terminated
+	// by a stop codon.
+	Partial bool
+}
+
+func (o *ORF) Start() int                    { return o.From }
+func (o *ORF) End() int                      { return o.To }
+func (o *ORF) Name() string                  { return o.ID }
+func (o *ORF) Description() string           { return "ORF" }
+func (o *ORF) Location() feat.Feature        { return o.Loc }
+func (o *ORF) Orientation() feat.Orientation { return o.Orient }
+
+// Len returns the length of the ORF in bases.
+func (o *ORF) Len() int {
+	if o.From <= o.To {
+		return o.To - o.From
+	}
+	return o.Loc.End() - o.From + o.To - o.Loc.Start()
+}
+
+// CodingTranscript returns a gene.CodingTranscript with a single exon and a coding region
+// spanning the ORF. The transcript of an ORF that spans the origin of a circular sequence
+// extends beyond the end of the sequence.
+func (o *ORF) CodingTranscript() (*gene.CodingTranscript, error) {
+	t := &gene.CodingTranscript{
+		ID:       o.ID,
+		Loc:      o.Loc,
+		Offset:   o.From,
+		Orient:   o.Orient,
+		Desc:     o.Description(),
+		CDSstart: 0,
+		CDSend:   o.Len(),
+	}
+	err := t.SetExons(gene.Exon{Transcript: t, Length: o.Len()})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Finder finds open reading frames in nucleic acid sequences.
+type Finder struct {
+	// GeneticCode is the genetic code used to identify
+	// stop codons and, if Starts is nil, start codons.
+	// If GeneticCode is nil, alphabet.Standard is used.
+	GeneticCode *alphabet.GeneticCode
+
+	// Starts holds the start codons. If Starts is nil,
+	// the initiation codons of GeneticCode are used.
+	Starts []string
+
+	// MinLength is the minimum length of a reported
+	// ORF in bases, including the stop codon.
+	MinLength int
+
+	// Partial specifies that ORFs that run off the end
+	// of a linear sequence without a stop codon are
+	// reported. The reported ORF holds the complete
+	// codons from the start codon to the end of the
+	// sequence.
+	Partial bool
+}
+
+// Find returns the ORFs in all six reading frames of s, sorted by From position. Each ORF
+// starts at the first start codon following the preceding in-frame stop codon, so ORFs
+// nested within longer ORFs in the same frame are not reported. If s is circular, ORFs may
+// span the origin, but ORFs in reading frames without a stop codon are not reported. Codons
+// containing ambiguous bases are neither start nor stop codons.
+func (f Finder) Find(s seq.Sequence) ([]*ORF, error) {
+	if m := s.Alphabet().Moltype(); m != feat.DNA && m != feat.RNA {
+		return nil, errors.New("orf: sequence is not a nucleic acid")
+	}
+	gc := f.GeneticCode
+	if gc == nil {
+		gc = alphabet.Standard
+	}
+	var isStart func(alphabet.Letter) bool
+	if f.Starts == nil {
+		isStart = gc.IsStart
+	} else {
+		starts := make(map[alphabet.Letter]bool)
+		for _, c := range f.Starts {
+			if len(c) != 3 {
+				return nil, fmt.Errorf("orf: invalid start codon %q", c)
+			}
+			l := alphabet.CodonOf(alphabet.Letter(c[0]), alphabet.Letter(c[1]), alphabet.Letter(c[2]))
+			if !alphabet.Codon.IsValid(l) || l == alphabet.Codon.Gap() || l == alphabet.Codon.Ambiguous() {
+				return nil, fmt.Errorf("orf: invalid start codon %q", c)
+			}
+			starts[l] = true
+		}
+		isStart = func(l alphabet.Letter) bool { return starts[l] }
+	}
+
+	n := s.Len()
+	fwd := make([]alphabet.Letter, n)
+	rev := make([]alphabet.Letter, n)
+	for i := range fwd {
+		fwd[i] = s.At(s.Start() + i).L
+		rev[n-1-i] = complement(fwd[i])
+	}
+	circular := s.Conformation() == feat.Circular
+
+	var orfs []*ORF
+	for _, strand := range []struct {
+		l      []alphabet.Letter
+		orient feat.Orientation
+	}{
+		{l: fwd, orient: feat.Forward},
+		{l: rev, orient: feat.Reverse},
+	} {
+		sc := scanner{
+			l:        strand.l,
+			circular: circular,
+			isStart:  isStart,
+			isStop:   gc.IsStop,
+		}
+		for _, o := range sc.scan(f.Partial) {
+			if o.len < f.MinLength {
+				continue
+			}
+			from := o.from
+			frame := from%3 + 1
+			if strand.orient == feat.Reverse {
+				from = ((n-o.from-o.len)%n + n) % n
+				frame = -frame
+			}
+			to := from + o.len
+			if to > n {
+				to -= n
+			}
+			orfs = append(orfs, &ORF{
+				Loc:     s,
+				From:    s.Start() + from,
+				To:      s.Start() + to,
+				Orient:  strand.orient,
+				Frame:   frame,
+				Partial: o.partial,
+			})
+		}
+	}
+	sort.Slice(orfs, func(i, j int) bool {
+		if orfs[i].From != orfs[j].From {
+			return orfs[i].From < orfs[j].From
+		}
+		return orfs[i].Orient > orfs[j].Orient
+	})
+	return orfs, nil
+}
+
+// complement returns the complement of the nucleic acid base b, or n if b is not a, c,
+// g, t or u.
+func complement(b alphabet.Letter) alphabet.Letter {
+	switch b | ('a' - 'A') {
+	case 'a':
+		return 't'
+	case 'c':
+		return 'g'
+	case 'g':
+		return 'c'
+	case 't', 'u':
+		return 'a'
+	}
+	return 'n'
+}
+
+// scanner finds ORFs on one strand of a sequence.
+type scanner struct {
+	l        []alphabet.Letter
+	circular bool
+	isStart  func(alphabet.Letter) bool
+	isStop   func(alphabet.Letter) bool
+}
+
+// orf is an ORF on the scanned strand.
+type orf struct {
+	from, len int
+	partial   bool
+}
+
+func (s scanner) codon(p int) alphabet.Letter {
+	n := len(s.l)
+	return alphabet.CodonOf(s.l[p], s.l[(p+1)%n], s.l[(p+2)%n])
+}
+
+// scan returns the ORFs of the strand. Codon positions are visited in reading order: in
+// three frames for linear sequences, and in cycles of positions three bases apart modulo
+// the sequence length for circular sequences.
+func (s scanner) scan(partial bool) []orf {
+	n := len(s.l)
+	if !s.circular {
+		var orfs []orf
+		for frame := 0; frame < 3; frame++ {
+			var pos []int
+			for p := frame; p+3 <= n; p += 3 {
+				pos = append(pos, p)
+			}
+			orfs = s.walk(orfs, pos, partial)
+		}
+		return orfs
+	}
+
+	var orfs []orf
+	visited := make([]bool, n)
+	for p0 := 0; p0 < n; p0++ {
+		if visited[p0] {
+			continue
+		}
+		var cycle []int
+		for p := p0; !visited[p]; p = (p + 3) % n {
+			visited[p] = true
+			cycle = append(cycle, p)
+		}
+
+		// Begin the walk after a stop codon so that each
+		// ORF in the cycle ends with a stop.
+		stop := -1
+		for i, p := range cycle {
+			if s.isStop(s.codon(p)) {
+				stop = i
+				break
+			}
+		}
+		if stop < 0 {
+			continue
+		}
+		pos := append(cycle[stop+1:len(cycle):len(cycle)], cycle[:stop+1]...)
+		for _, o := range s.walk(nil, pos, false) {
+			if o.len < n {
+				orfs = append(orfs, o)
+			}
+		}
+	}
+	return orfs
+}
+
+// walk appends to dst the ORFs found by reading the codons at the positions in pos.
+func (s scanner) walk(dst []orf, pos []int, partial bool) []orf {
+	open := -1
+	for i, p := range pos {
+		c := s.codon(p)
+		switch {
+		case open < 0 && s.isStart(c):
+			open = i
+		case open >= 0 && s.isStop(c):
+			dst = append(dst, orf{from: pos[open], len: 3 * (i - open + 1)})
+			open = -1
+		}
+	}
+	if open >= 0 && partial {
+		dst = append(dst, orf{from: pos[open], len: 3 * (len(pos) - open), partial: true})
+	}
+	return dst
+}
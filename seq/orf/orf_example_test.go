@@ -0,0 +1,50 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package orf_test
+
+import (
+	"fmt"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/orf"
+	"github.com/biogo/biogo/seq/sequtils"
+)
+
+func ExampleFinder_Find() {
+	s := linear.NewSeq("plasmid", alphabet.BytesToLetters([]byte("gcctaacccatg")), alphabet.DNA)
+	s.Conform = feat.Circular
+
+	orfs, err := orf.Finder{}.Find(s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, o := range orfs {
+		// Extract the ORF, wrapping the origin if necessary,
+		// and translate it on the ORF's strand.
+		t := linear.NewSeq(s.ID, nil, s.Alpha)
+		err = sequtils.Truncate(t, s, o.Start(), o.End())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		frame := 1
+		if o.Orientation() == feat.Reverse {
+			frame = -1
+		}
+		p, err := t.Translate(alphabet.Standard, frame, false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%d-%d %v frame %d: %-s\n", o.Start(), o.End(), o.Orientation(), o.Frame, p)
+	}
+
+	// Output:
+	// 2-11 reverse frame -2: mg*
+	// 9-6 forward frame 1: ma*
+}
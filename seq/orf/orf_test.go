@@ -0,0 +1,149 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package orf
+
+import (
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type span struct {
+	from, to int
+	orient   feat.Orientation
+	frame    int
+	partial  bool
+}
+
+func spans(orfs []*ORF) []span {
+	var s []span
+	for _, o := range orfs {
+		s = append(s, span{o.From, o.To, o.Orient, o.Frame, o.Partial})
+	}
+	return s
+}
+
+func (s *S) TestFind(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("ccatgaaaccctgagg")), alphabet.DNA)
+	for _, t := range []struct {
+		f    Finder
+		want []span
+	}{
+		{
+			f:    Finder{},
+			want: []span{{2, 14, feat.Forward, 3, false}},
+		},
+		{
+			f:    Finder{Starts: []string{"ATG"}},
+			want: []span{{2, 14, feat.Forward, 3, false}},
+		},
+		{
+			f:    Finder{MinLength: 13},
+			want: nil,
+		},
+		{
+			f: Finder{Partial: true},
+			want: []span{
+				{1, 4, feat.Reverse, -1, true},
+				{2, 14, feat.Forward, 3, false},
+				{10, 16, feat.Forward, 2, true},
+			},
+		},
+		{
+			f: Finder{Partial: true, MinLength: 6},
+			want: []span{
+				{2, 14, feat.Forward, 3, false},
+				{10, 16, feat.Forward, 2, true},
+			},
+		},
+	} {
+		orfs, err := t.f.Find(sq)
+		c.Assert(err, check.Equals, nil)
+		c.Check(spans(orfs), check.DeepEquals, t.want, check.Commentf("%+v", t.f))
+		for _, o := range orfs {
+			c.Check(o.Location(), check.Equals, sq)
+			c.Check(o.Len(), check.Equals, o.To-o.From)
+		}
+	}
+
+	sq.Offset = 100
+	orfs, err := Finder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{{102, 114, feat.Forward, 3, false}})
+
+	_, err = Finder{Starts: []string{"at"}}.Find(sq)
+	c.Check(err, check.ErrorMatches, `orf: invalid start codon "at"`)
+	_, err = Finder{Starts: []string{"anc"}}.Find(sq)
+	c.Check(err, check.ErrorMatches, `orf: invalid start codon "anc"`)
+	_, err = Finder{}.Find(linear.NewSeq("protein", alphabet.BytesToLetters([]byte("mkv")), alphabet.Protein))
+	c.Check(err, check.ErrorMatches, "orf: sequence is not a nucleic acid")
+}
+
+func (s *S) TestFindCircular(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("gcctaacccatg")), alphabet.DNA)
+	orfs, err := Finder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{{2, 11, feat.Reverse, -2, false}})
+
+	sq.Conform = feat.Circular
+	orfs, err = Finder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{
+		{2, 11, feat.Reverse, -2, false},
+		{9, 6, feat.Forward, 1, false},
+	})
+	c.Check(orfs[1].Len(), check.Equals, 9)
+
+	// With a sequence length that is not a multiple of three
+	// the reading frame changes on crossing the origin.
+	sq = linear.NewSeq("test", alphabet.BytesToLetters([]byte("aaaaaatgagcccatg")), alphabet.DNA)
+	sq.Conform = feat.Circular
+	orfs, err = Finder{Starts: []string{"atg"}}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{
+		{5, 1, feat.Forward, 3, false},
+		{13, 9, feat.Forward, 2, false},
+	})
+}
+
+func (s *S) TestFindGeneticCode(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("atgaaatgaaaaaga")), alphabet.DNA)
+	orfs, err := Finder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{{0, 9, feat.Forward, 1, false}})
+
+	orfs, err = Finder{GeneticCode: alphabet.VertebrateMitochondrial}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spans(orfs), check.DeepEquals, []span{{0, 15, feat.Forward, 1, false}})
+}
+
+func (s *S) TestCodingTranscript(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("ccatgaaaccctgagg")), alphabet.DNA)
+	orfs, err := Finder{}.Find(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(orfs), check.Equals, 1)
+	orfs[0].ID = "orf1"
+	t, err := orfs[0].CodingTranscript()
+	c.Assert(err, check.Equals, nil)
+	c.Check(t.Name(), check.Equals, "orf1")
+	c.Check(t.Location(), check.Equals, sq)
+	c.Check(t.Start(), check.Equals, 2)
+	c.Check(t.End(), check.Equals, 14)
+	c.Check(t.Orientation(), check.Equals, feat.Forward)
+	cds := t.CDS()
+	c.Check(cds.Start(), check.Equals, 0)
+	c.Check(cds.Len(), check.Equals, 12)
+	c.Check(len(t.Exons()), check.Equals, 1)
+}
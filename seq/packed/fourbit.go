@@ -0,0 +1,303 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package packed
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+var (
+	// fourBitLetters is indexed by 4-bit code. Each code is the
+	// union of bits a=1, c=2, g=4 and t=8, with the gap as 0.
+	fourBitLetters = [16]alphabet.Letter{
+		'-', 'a', 'c', 'm', 'g', 'r', 's', 'v',
+		't', 'w', 'y', 'h', 'k', 'd', 'b', 'n',
+	}
+
+	// fourBitCode maps letters to 4-bit codes. Letters
+	// without a code are stored as n.
+	fourBitCode = func() (t [256]byte) {
+		for i := range t {
+			t[i] = 0xf
+		}
+		for c, b := range fourBitLetters {
+			t[b] = byte(c)
+			if b != '-' {
+				t[b&^('a'-'A')] = byte(c)
+			}
+		}
+		t['u'], t['U'] = 0x8, 0x8
+		return t
+	}()
+
+	// fourBitComplement maps 4-bit codes to the code of their
+	// complement by reversing the order of the base bits.
+	fourBitComplement = func() (t [16]byte) {
+		for c := range t {
+			t[c] = byte(c&1<<3 | c&2<<1 | c&4>>1 | c&8>>3)
+		}
+		return t
+	}()
+)
+
+// FourBitLetters is a slice of IUPAC nucleic acid bases packed two to a byte. FourBitLetters
+// satisfies the alphabet.Slice interface with the same sharing semantics as a Go slice.
+type FourBitLetters struct {
+	data []byte
+
+	off, length, capacity int
+}
+
+var _ alphabet.Slice = FourBitLetters{}
+
+func newFourBitLetters(b []alphabet.Letter) FourBitLetters {
+	l := FourBitLetters{
+		data:     make([]byte, (len(b)+1)/2),
+		length:   len(b),
+		capacity: len(b),
+	}
+	for i, c := range b {
+		l.data[i>>1] |= fourBitCode[c] << (uint(i&1) << 2)
+	}
+	return l
+}
+
+// Make makes a FourBitLetters with len and cap as provided. The bases of the returned
+// slice are all gaps.
+func (l FourBitLetters) Make(len, cap int) alphabet.Slice {
+	return FourBitLetters{
+		data:     make([]byte, (cap+1)/2),
+		length:   len,
+		capacity: cap,
+	}
+}
+
+// Len returns the number of bases in the slice.
+func (l FourBitLetters) Len() int { return l.length }
+
+// Cap returns the capacity of the slice.
+func (l FourBitLetters) Cap() int { return l.capacity }
+
+// Slice returns the bases of the slice from start to end. Slice will panic if the indices
+// are not within the capacity of the slice.
+func (l FourBitLetters) Slice(start, end int) alphabet.Slice {
+	if start < 0 || end < start || end > l.capacity {
+		panic("packed: slice index out of range")
+	}
+	l.off += start
+	l.length = end - start
+	l.capacity -= start
+	return l
+}
+
+// Append appends the bases of a to the receiver, allocating a new backing array if the
+// capacity of the receiver is exceeded. Append will panic if a is not a FourBitLetters.
+func (l FourBitLetters) Append(a alphabet.Slice) alphabet.Slice {
+	src := a.(FourBitLetters)
+	n := l.length + src.length
+	dst := l
+	if n > l.capacity {
+		dst = l.Make(l.length, max(n, 2*l.capacity)).(FourBitLetters)
+		dst.copyAt(0, l, l.length)
+	}
+	dst.copyAt(l.length, src, src.length)
+	dst.length = n
+	return dst
+}
+
+// Copy copies bases from a into the receiver, returning the number of bases copied. Copy
+// will panic if a is not a FourBitLetters.
+func (l FourBitLetters) Copy(a alphabet.Slice) int {
+	src := a.(FourBitLetters)
+	n := min(l.length, src.length)
+	l.copyAt(0, src, n)
+	return n
+}
+
+// copyAt copies the first n bases of src into the backing array of the receiver starting
+// at position i of the receiver.
+func (l FourBitLetters) copyAt(i int, src FourBitLetters, n int) {
+	if n == 0 {
+		return
+	}
+	d, s := l.off+i, src.off
+	switch {
+	case sameArray(l.data, src.data):
+		if d > s {
+			for k := n - 1; k >= 0; k-- {
+				set4(l.data, d+k, get4(src.data, s+k))
+			}
+		} else {
+			for k := 0; k < n; k++ {
+				set4(l.data, d+k, get4(src.data, s+k))
+			}
+		}
+	case d&1 == s&1:
+		// Copy whole bytes where the source and destination are aligned.
+		k := 0
+		if d&1 != 0 {
+			set4(l.data, d, get4(src.data, s))
+			k++
+		}
+		w := (n - k) &^ 1
+		copy(l.data[(d+k)>>1:(d+k+w)>>1], src.data[(s+k)>>1:(s+k+w)>>1])
+		if k += w; k < n {
+			set4(l.data, d+k, get4(src.data, s+k))
+		}
+	default:
+		for k := 0; k < n; k++ {
+			set4(l.data, d+k, get4(src.data, s+k))
+		}
+	}
+}
+
+// at returns the base at position i of the slice.
+func (l FourBitLetters) at(i int) alphabet.Letter {
+	if i < 0 || i >= l.length {
+		panic("packed: index out of range")
+	}
+	return fourBitLetters[get4(l.data, l.off+i)]
+}
+
+// set sets the base at position i of the slice to b.
+func (l FourBitLetters) set(i int, b alphabet.Letter) {
+	if i < 0 || i >= l.length {
+		panic("packed: index out of range")
+	}
+	set4(l.data, l.off+i, fourBitCode[b])
+}
+
+// reverse reverses the order of bases in the slice, complementing them if comp is true.
+func (l FourBitLetters) reverse(comp bool) {
+	d := l.data
+	for i, j := l.off, l.off+l.length-1; i <= j; i, j = i+1, j-1 {
+		a, b := get4(d, i), get4(d, j)
+		if comp {
+			a, b = fourBitComplement[a], fourBitComplement[b]
+		}
+		set4(d, i, b)
+		set4(d, j, a)
+	}
+}
+
+// letters returns the bases of the slice as alphabet.Letters.
+func (l FourBitLetters) letters() alphabet.Letters {
+	b := make(alphabet.Letters, l.length)
+	for i := range b {
+		b[i] = fourBitLetters[get4(l.data, l.off+i)]
+	}
+	return b
+}
+
+// clone returns a copy of the slice with its own backing array.
+func (l FourBitLetters) clone() FourBitLetters {
+	c := l.Make(l.length, l.length).(FourBitLetters)
+	c.copyAt(0, l, l.length)
+	return c
+}
+
+// String returns a string representation of the bases in the slice.
+func (l FourBitLetters) String() string { return l.letters().String() }
+
+// A FourBit is a nucleic acid sequence of IUPAC bases packed two to a byte. Letters that
+// are not IUPAC nucleotide codes or a gap are stored as n, and u is stored as t.
+type FourBit struct {
+	seq.Annotation
+	Seq FourBitLetters
+}
+
+// Interface guarantees
+var (
+	_ feat.Feature = (*FourBit)(nil)
+	_ seq.Sequence = (*FourBit)(nil)
+)
+
+// NewFourBit creates a new FourBit with the given id and letter sequence, and the
+// alphabet.DNAredundant alphabet.
+func NewFourBit(id string, b []alphabet.Letter) *FourBit {
+	return &FourBit{
+		Annotation: seq.Annotation{
+			ID:     id,
+			Alpha:  alphabet.DNAredundant,
+			Strand: seq.Plus,
+		},
+		Seq: newFourBitLetters(b),
+	}
+}
+
+// FourBitOf returns a FourBit holding the letters and annotation of s. The alphabet of
+// the returned sequence is alphabet.DNAredundant.
+func FourBitOf(s *linear.Seq) *FourBit {
+	t := &FourBit{Annotation: *s.CloneAnnotation(), Seq: newFourBitLetters(s.Seq)}
+	t.Alpha = alphabet.DNAredundant
+	return t
+}
+
+// Linear returns a linear.Seq holding the letters and annotation of the sequence.
+func (s *FourBit) Linear() *linear.Seq {
+	return &linear.Seq{Annotation: *s.CloneAnnotation(), Seq: s.Seq.letters()}
+}
+
+// Slice returns the sequence data as a alphabet.Slice.
+func (s *FourBit) Slice() alphabet.Slice { return s.Seq }
+
+// SetSlice sets the sequence data represented by the sequence. SetSlice will panic if sl
+// is not a FourBitLetters.
+func (s *FourBit) SetSlice(sl alphabet.Slice) { s.Seq = sl.(FourBitLetters) }
+
+// At returns the letter at position pos.
+func (s *FourBit) At(i int) alphabet.QLetter {
+	return alphabet.QLetter{
+		L: s.Seq.at(i - s.Offset),
+		Q: seq.DefaultQphred,
+	}
+}
+
+// Set sets the letter at position pos to l.
+func (s *FourBit) Set(i int, l alphabet.QLetter) error {
+	s.Seq.set(i-s.Offset, l.L)
+	return nil
+}
+
+// Len returns the length of the sequence.
+func (s *FourBit) Len() int { return s.Seq.Len() }
+
+// Start returns the start position of the sequence in coordinates relative to the sequence
+// location.
+func (s *FourBit) Start() int { return s.Offset }
+
+// End returns the end position of the sequence in coordinates relative to the sequence
+// location.
+func (s *FourBit) End() int { return s.Offset + s.Len() }
+
+// Clone returns a copy of the sequence.
+func (s *FourBit) Clone() seq.Sequence {
+	c := *s
+	c.Seq = s.Seq.clone()
+	return &c
+}
+
+// New returns an empty *FourBit sequence with the same alphabet.
+func (s *FourBit) New() seq.Sequence {
+	return &FourBit{Annotation: seq.Annotation{Alpha: s.Alpha}}
+}
+
+// RevComp reverse complements the sequence.
+func (s *FourBit) RevComp() {
+	s.Seq.reverse(true)
+	s.Strand = -s.Strand
+}
+
+// Reverse reverses the order of letters in the the sequence without complementing them.
+func (s *FourBit) Reverse() {
+	s.Seq.reverse(false)
+	s.Strand = seq.None
+}
+
+// String returns a string representation of the sequence data only.
+func (s *FourBit) String() string { return s.Seq.String() }
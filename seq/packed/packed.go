@@ -0,0 +1,127 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package packed provides nucleic acid sequence types that store bases in two or four bits.
+//
+// The TwoBit type holds four bases per byte and records runs of n separately, in the manner
+// of the UCSC 2bit format. The FourBit type holds two bases per byte and represents the full
+// set of IUPAC nucleotide codes. Neither type retains letter case; letters are returned in
+// lower case.
+package packed
+
+import (
+	"sort"
+)
+
+// get2 and set2 get and set the 2-bit code at base position i of d.
+func get2(d []byte, i int) byte { return d[i>>2] >> (uint(i&3) << 1) & 0x3 }
+func set2(d []byte, i int, v byte) {
+	s := uint(i&3) << 1
+	d[i>>2] = d[i>>2]&^(0x3<<s) | v<<s
+}
+
+// get4 and set4 get and set the 4-bit code at base position i of d.
+func get4(d []byte, i int) byte { return d[i>>1] >> (uint(i&1) << 2) & 0xf }
+func set4(d []byte, i int, v byte) {
+	s := uint(i&1) << 2
+	d[i>>1] = d[i>>1]&^(0xf<<s) | v<<s
+}
+
+// sameArray returns whether a and b share a backing array.
+func sameArray(a, b []byte) bool {
+	return len(a) != 0 && len(b) != 0 && &a[0] == &b[0]
+}
+
+// run is a half-open interval of base positions.
+type run struct{ start, end int }
+
+// nMask holds the n runs of a 2-bit backing array. The runs are sorted and
+// neither overlap nor abut. A nil *nMask is an empty mask.
+type nMask struct {
+	runs []run
+}
+
+// search returns the index of the first run ending after p.
+func (m *nMask) search(p int) int {
+	return sort.Search(len(m.runs), func(i int) bool { return m.runs[i].end > p })
+}
+
+// contains returns whether position p is in a run.
+func (m *nMask) contains(p int) bool {
+	if m == nil {
+		return false
+	}
+	i := m.search(p)
+	return i < len(m.runs) && m.runs[i].start <= p
+}
+
+// within returns the runs intersecting [start, end), clipped to the interval.
+func (m *nMask) within(start, end int) []run {
+	if m == nil {
+		return nil
+	}
+	var r []run
+	for i := m.search(start); i < len(m.runs) && m.runs[i].start < end; i++ {
+		r = append(r, run{start: max(m.runs[i].start, start), end: min(m.runs[i].end, end)})
+	}
+	return r
+}
+
+// add adds [start, end) to the mask, merging it with overlapping and abutting runs.
+func (m *nMask) add(start, end int) {
+	if start >= end {
+		return
+	}
+	i := sort.Search(len(m.runs), func(k int) bool { return m.runs[k].end >= start })
+	j := i
+	for ; j < len(m.runs) && m.runs[j].start <= end; j++ {
+		start = min(start, m.runs[j].start)
+		end = max(end, m.runs[j].end)
+	}
+	if i == j {
+		m.runs = append(m.runs, run{})
+		copy(m.runs[i+1:], m.runs[i:])
+		m.runs[i] = run{start: start, end: end}
+		return
+	}
+	m.runs[i] = run{start: start, end: end}
+	m.runs = append(m.runs[:i+1], m.runs[j:]...)
+}
+
+// clear removes [start, end) from the mask.
+func (m *nMask) clear(start, end int) {
+	if m == nil || start >= end {
+		return
+	}
+	i := m.search(start)
+	j := i
+	for j < len(m.runs) && m.runs[j].start < end {
+		j++
+	}
+	if i == j {
+		return
+	}
+	var keep []run
+	if m.runs[i].start < start {
+		keep = append(keep, run{start: m.runs[i].start, end: start})
+	}
+	if m.runs[j-1].end > end {
+		keep = append(keep, run{start: end, end: m.runs[j-1].end})
+	}
+	m.runs = append(m.runs[:i], append(keep, m.runs[j:]...)...)
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package packed_test
+
+import (
+	"fmt"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/packed"
+	"github.com/biogo/biogo/seq/sequtils"
+)
+
+func ExampleTwoBitOf() {
+	s := linear.NewSeq("chr", alphabet.BytesToLetters([]byte("ACGTNNNNNNacgtTTGCA")), alphabet.DNA)
+	s.Conform = feat.Circular
+
+	t := packed.TwoBitOf(s)
+	fmt.Println(t)
+
+	err := sequtils.Truncate(t, t, 15, 4)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(t, t.Start(), t.End())
+
+	t.RevComp()
+	fmt.Printf("%-s\n", t.Linear())
+
+	// Output:
+	// acgtnnnnnnacgtttgca
+	// tgcaacgt 15 23
+	// acgttgca
+}
+
+func ExampleFourBit_RevComp() {
+	s := packed.NewFourBit("primer", alphabet.BytesToLetters([]byte("GGATCNNRYC")))
+	s.RevComp()
+	fmt.Println(s)
+
+	// Output:
+	// grynngatcc
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package packed
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/sequtils"
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func lettersOf(s string) []alphabet.Letter { return alphabet.BytesToLetters([]byte(s)) }
+
+func (s *S) TestMask(c *check.C) {
+	m := &nMask{}
+	for _, r := range []run{{10, 12}, {2, 4}, {6, 8}, {4, 5}, {12, 13}} {
+		m.add(r.start, r.end)
+	}
+	c.Check(m.runs, check.DeepEquals, []run{{2, 5}, {6, 8}, {10, 13}})
+	c.Check(m.within(3, 11), check.DeepEquals, []run{{3, 5}, {6, 8}, {10, 11}})
+	c.Check(m.contains(4), check.Equals, true)
+	c.Check(m.contains(5), check.Equals, false)
+	m.add(5, 6)
+	c.Check(m.runs, check.DeepEquals, []run{{2, 8}, {10, 13}})
+	m.clear(3, 4)
+	c.Check(m.runs, check.DeepEquals, []run{{2, 3}, {4, 8}, {10, 13}})
+	m.clear(0, 11)
+	c.Check(m.runs, check.DeepEquals, []run{{11, 13}})
+	m.clear(0, 20)
+	c.Check(m.runs, check.DeepEquals, []run{})
+}
+
+func (s *S) TestTwoBit(c *check.C) {
+	t := NewTwoBit("test", lettersOf("acgtnnNNacgTRyaaaauX"))
+	c.Check(t.String(), check.Equals, "acgtnnnnacgtnnaaaatn")
+	c.Check(t.Seq.mask.runs, check.DeepEquals, []run{{4, 8}, {12, 14}, {19, 20}})
+	c.Check(len(t.Seq.data), check.Equals, 5)
+	c.Check(t.Len(), check.Equals, 20)
+	c.Check(t.Alphabet(), check.Equals, alphabet.DNA)
+
+	t.Offset = 10
+	c.Check(t.At(14), check.Equals, alphabet.QLetter{L: 'n', Q: seq.DefaultQphred})
+	c.Check(t.At(18), check.Equals, alphabet.QLetter{L: 'a', Q: seq.DefaultQphred})
+	c.Check(t.Set(15, alphabet.QLetter{L: 'G'}), check.Equals, nil)
+	c.Check(t.Set(10, alphabet.QLetter{L: 'r'}), check.Equals, nil)
+	c.Check(t.String(), check.Equals, "ncgtngnnacgtnnaaaatn")
+	c.Check(t.Seq.mask.runs, check.DeepEquals, []run{{0, 1}, {4, 5}, {6, 8}, {12, 14}, {19, 20}})
+	c.Check(func() { t.At(9) }, check.Panics, "packed: index out of range")
+}
+
+func (s *S) TestFourBit(c *check.C) {
+	t := NewFourBit("test", lettersOf("-acmgrsvtwyhkdbnACMGRSVTWYHKDBNux"))
+	c.Check(t.String(), check.Equals, "-acmgrsvtwyhkdbnacmgrsvtwyhkdbntn")
+	c.Check(t.Alphabet(), check.Equals, alphabet.DNAredundant)
+
+	t.Offset = 5
+	c.Check(t.At(7), check.Equals, alphabet.QLetter{L: 'c', Q: seq.DefaultQphred})
+	c.Check(t.Set(5, alphabet.QLetter{L: 'Y'}), check.Equals, nil)
+	c.Check(t.At(5).L, check.Equals, alphabet.Letter('y'))
+
+	t = NewFourBit("test", lettersOf("acmgrsvtwyhkdbn-"))
+	t.RevComp()
+	c.Check(t.String(), check.Equals, "-nvhmdrwabsyckgt")
+	c.Check(t.Strand, check.Equals, seq.Minus)
+	t.Reverse()
+	c.Check(t.String(), check.Equals, "tgkcysbawrdmhvn-")
+	c.Check(t.Strand, check.Equals, seq.None)
+}
+
+func (s *S) TestLinear(c *check.C) {
+	l := linear.NewSeq("test", lettersOf("acgtnnnnACGTrykm"), alphabet.DNAredundant)
+	l.Desc = "description"
+	l.Offset = 3
+	l.Strand = seq.Minus
+	l.Conform = feat.Circular
+
+	t := TwoBitOf(l)
+	c.Check(t.ID, check.Equals, "test")
+	c.Check(t.Desc, check.Equals, "description")
+	c.Check(t.Offset, check.Equals, 3)
+	c.Check(t.Strand, check.Equals, seq.Minus)
+	c.Check(t.Conformation(), check.Equals, feat.Circular)
+	c.Check(t.Alphabet(), check.Equals, alphabet.DNA)
+	tl := t.Linear()
+	c.Check(tl.String(), check.Equals, "acgtnnnnacgtnnnn")
+	c.Check(tl.Offset, check.Equals, 3)
+
+	f := FourBitOf(l)
+	c.Check(f.Alphabet(), check.Equals, alphabet.DNAredundant)
+	fl := f.Linear()
+	c.Check(fl.String(), check.Equals, "acgtnnnnacgtrykm")
+	c.Check(fl.Desc, check.Equals, "description")
+	c.Check(fl.Conformation(), check.Equals, feat.Circular)
+}
+
+func (s *S) TestTwoBitRevComp(c *check.C) {
+	t := NewTwoBit("test", lettersOf("ccaacgnntaagnttt"))
+	// Reverse complement a slice of the sequence sharing the
+	// backing array to check that bases and n runs outside the
+	// slice are untouched.
+	sub := NewTwoBit("sub", nil)
+	sub.SetSlice(t.Seq.Slice(2, 13))
+	sub.RevComp()
+	c.Check(sub.String(), check.Equals, "ncttanncgtt")
+	c.Check(t.String(), check.Equals, "ccncttanncgttttt")
+	c.Check(sub.Strand, check.Equals, seq.Minus)
+	sub.Reverse()
+	c.Check(t.String(), check.Equals, "ccttgcnnattcnttt")
+
+	t = NewTwoBit("test", lettersOf("acgtn"))
+	t.RevComp()
+	c.Check(t.String(), check.Equals, "nacgt")
+}
+
+func (s *S) TestSlice(c *check.C) {
+	for _, t := range []struct {
+		name string
+		seq  seq.Sequence
+	}{
+		{name: "two bit", seq: NewTwoBit("test", lettersOf("acgtnnacgtaannnggtt"))},
+		{name: "four bit", seq: NewFourBit("test", lettersOf("acgtnnacgtaarykggtt"))},
+	} {
+		want := t.seq.(interface{ String() string }).String()
+		l := alphabet.Letters(lettersOf(want))
+		sl := t.seq.Slice()
+		c.Check(sl.Len(), check.Equals, len(want), check.Commentf("%s", t.name))
+
+		// Slicing shares the backing array.
+		sub := sl.Slice(3, 9)
+		c.Check(sub.Len(), check.Equals, 6)
+		c.Check(sub.Cap(), check.Equals, len(want)-3)
+		c.Check(sub.(interface{ String() string }).String(), check.Equals, want[3:9])
+		c.Check(sub.Copy(sl.Slice(0, 4)), check.Equals, 4)
+		copy(l[3:9], l[0:4])
+		c.Check(sl.(interface{ String() string }).String(), check.Equals, l.String(), check.Commentf("%s", t.name))
+
+		// Overlapping copies behave as the builtin copy.
+		c.Check(sl.Slice(1, 12).Copy(sl.Slice(0, 11)), check.Equals, 11)
+		copy(l[1:12], l[0:11])
+		c.Check(sl.(interface{ String() string }).String(), check.Equals, l.String(), check.Commentf("%s", t.name))
+		c.Check(sl.Slice(0, 11).Copy(sl.Slice(2, 13)), check.Equals, 11)
+		copy(l[0:11], l[2:13])
+		c.Check(sl.(interface{ String() string }).String(), check.Equals, l.String(), check.Commentf("%s", t.name))
+
+		// Appending within capacity writes to the backing array
+		// and beyond capacity allocates.
+		app := sl.Slice(0, 4).Append(sl.Slice(10, 14))
+		l = append(l[:4], l[10:14]...)[:len(want)]
+		c.Check(app.(interface{ String() string }).String(), check.Equals, l[:8].String())
+		c.Check(sl.(interface{ String() string }).String(), check.Equals, l.String(), check.Commentf("%s", t.name))
+		app = sl.Append(sl.Slice(0, 5))
+		c.Check(app.Len(), check.Equals, len(want)+5)
+		c.Check(app.(interface{ String() string }).String(), check.Equals, l.String()+l[:5].String())
+		app.Slice(0, 1).Copy(sl.Slice(5, 6))
+		c.Check(sl.(interface{ String() string }).String(), check.Equals, l.String(), check.Commentf("%s", t.name))
+
+		m := sl.Make(2, 10)
+		c.Check(m.Len(), check.Equals, 2)
+		c.Check(m.Cap(), check.Equals, 10)
+		c.Check(func() { sl.Slice(0, len(want)+1) }, check.Panics, "packed: slice index out of range")
+	}
+}
+
+func randomLetters(rnd *rand.Rand, n int, letters string) []alphabet.Letter {
+	b := make([]alphabet.Letter, 0, n)
+	for len(b) < n {
+		if rnd.Intn(10) == 0 {
+			// Make a run of n.
+			for k := 1 + rnd.Intn(5); k > 0 && len(b) < n; k-- {
+				b = append(b, 'n')
+			}
+			continue
+		}
+		b = append(b, alphabet.Letter(letters[rnd.Intn(len(letters))]))
+	}
+	return b
+}
+
+func (s *S) TestSequtils(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, t := range []struct {
+		name    string
+		letters string
+		new     func([]alphabet.Letter) seq.Sequence
+	}{
+		{
+			name:    "two bit",
+			letters: "acgt",
+			new:     func(b []alphabet.Letter) seq.Sequence { return NewTwoBit("test", b) },
+		},
+		{
+			name:    "four bit",
+			letters: "acgtrykm",
+			new:     func(b []alphabet.Letter) seq.Sequence { return NewFourBit("test", b) },
+		},
+	} {
+		for i := 0; i < 50; i++ {
+			n := 1 + rnd.Intn(40)
+			b := randomLetters(rnd, n, t.letters)
+			p, l := t.new(b), linear.NewSeq("test", b, alphabet.DNAredundant)
+			p.SetOffset(5)
+			l.SetOffset(5)
+			p.SetConformation(feat.Circular)
+			l.Conform = feat.Circular
+
+			start, end := 5+rnd.Intn(n+1), 5+rnd.Intn(n+1)
+			pt, lt := p.New().(sequtils.Sliceable), l.New().(*linear.Seq)
+			c.Check(sequtils.Truncate(pt, p, start, end), check.Equals, nil)
+			c.Check(sequtils.Truncate(lt, l, start, end), check.Equals, nil)
+			c.Check(pt.(interface{ String() string }).String(), check.Equals, lt.String(),
+				check.Commentf("%s truncate %s %d-%d", t.name, l, start, end))
+
+			q := t.new(randomLetters(rnd, rnd.Intn(20), t.letters))
+			ql := linear.NewSeq("test", lettersOf(q.(interface{ String() string }).String()), alphabet.DNAredundant)
+			where := seq.Start
+			if rnd.Intn(2) == 0 {
+				where = seq.End
+			}
+			pj, lj := pt.(seq.Sequence).Clone(), lt.Clone()
+			c.Check(sequtils.Join(pj, q, where), check.Equals, nil)
+			c.Check(sequtils.Join(lj, ql, where), check.Equals, nil)
+			c.Check(pj.(interface{ String() string }).String(), check.Equals, lj.(*linear.Seq).String(),
+				check.Commentf("%s join %s %s %d", t.name, lt, ql, where))
+			c.Check(pj.Start(), check.Equals, lj.Start())
+
+			var fs fsSet
+			for k := rnd.Intn(4); k >= 0; k-- {
+				s := rnd.Intn(n)
+				fs = append(fs, &feature{start: 5 + s, end: 5 + s + rnd.Intn(n-s+1)})
+			}
+			p.SetConformation(feat.Linear)
+			ps, ls := p.New().(sequtils.Sliceable), l.New().(*linear.Seq)
+			c.Check(sequtils.Stitch(ps, p, fs), check.Equals, nil)
+			c.Check(sequtils.Stitch(ls, l, fs), check.Equals, nil)
+			c.Check(ps.(interface{ String() string }).String(), check.Equals, ls.String(),
+				check.Commentf("%s stitch %s", t.name, l))
+		}
+	}
+}
+
+type feature struct{ start, end int }
+
+func (f *feature) Start() int             { return f.start }
+func (f *feature) End() int               { return f.end }
+func (f *feature) Len() int               { return f.end - f.start }
+func (f *feature) Name() string           { return "" }
+func (f *feature) Description() string    { return "" }
+func (f *feature) Location() feat.Feature { return nil }
+
+type fsSet []feat.Feature
+
+func (s fsSet) Features() []feat.Feature { return s }
@@ -0,0 +1,335 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package packed
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+var (
+	twoBitLetters = [4]alphabet.Letter{'a', 'c', 'g', 't'}
+
+	// twoBitCode maps letters to 2-bit codes. Letters without
+	// a code are 0xff and are stored as n.
+	twoBitCode = func() (t [256]byte) {
+		for i := range t {
+			t[i] = 0xff
+		}
+		for c, b := range "acgt" {
+			t[b], t[b&^('a'-'A')] = byte(c), byte(c)
+		}
+		t['u'], t['U'] = 3, 3
+		return t
+	}()
+)
+
+// TwoBitLetters is a slice of nucleic acid bases packed four to a byte. Runs of n are held
+// in a mask shared by all slices of the same backing array. TwoBitLetters satisfies the
+// alphabet.Slice interface with the same sharing semantics as a Go slice.
+type TwoBitLetters struct {
+	data []byte
+	mask *nMask
+
+	off, length, capacity int
+}
+
+var _ alphabet.Slice = TwoBitLetters{}
+
+func newTwoBitLetters(b []alphabet.Letter) TwoBitLetters {
+	l := TwoBitLetters{
+		data:     make([]byte, (len(b)+3)/4),
+		mask:     &nMask{},
+		length:   len(b),
+		capacity: len(b),
+	}
+	start := -1
+	for i, c := range b {
+		v := twoBitCode[c]
+		if v == 0xff {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			l.mask.runs = append(l.mask.runs, run{start: start, end: i})
+			start = -1
+		}
+		l.data[i>>2] |= v << (uint(i&3) << 1)
+	}
+	if start >= 0 {
+		l.mask.runs = append(l.mask.runs, run{start: start, end: len(b)})
+	}
+	return l
+}
+
+// Make makes a TwoBitLetters with len and cap as provided. The bases of the returned
+// slice are all a.
+func (l TwoBitLetters) Make(len, cap int) alphabet.Slice {
+	return TwoBitLetters{
+		data:     make([]byte, (cap+3)/4),
+		mask:     &nMask{},
+		length:   len,
+		capacity: cap,
+	}
+}
+
+// Len returns the number of bases in the slice.
+func (l TwoBitLetters) Len() int { return l.length }
+
+// Cap returns the capacity of the slice.
+func (l TwoBitLetters) Cap() int { return l.capacity }
+
+// Slice returns the bases of the slice from start to end. Slice will panic if the indices
+// are not within the capacity of the slice.
+func (l TwoBitLetters) Slice(start, end int) alphabet.Slice {
+	if start < 0 || end < start || end > l.capacity {
+		panic("packed: slice index out of range")
+	}
+	l.off += start
+	l.length = end - start
+	l.capacity -= start
+	return l
+}
+
+// Append appends the bases of a to the receiver, allocating a new backing array if the
+// capacity of the receiver is exceeded. Append will panic if a is not a TwoBitLetters.
+func (l TwoBitLetters) Append(a alphabet.Slice) alphabet.Slice {
+	src := a.(TwoBitLetters)
+	n := l.length + src.length
+	dst := l
+	if n > l.capacity {
+		dst = l.Make(l.length, max(n, 2*l.capacity)).(TwoBitLetters)
+		dst.copyAt(0, l, l.length)
+	}
+	dst.copyAt(l.length, src, src.length)
+	dst.length = n
+	return dst
+}
+
+// Copy copies bases from a into the receiver, returning the number of bases copied. Copy
+// will panic if a is not a TwoBitLetters.
+func (l TwoBitLetters) Copy(a alphabet.Slice) int {
+	src := a.(TwoBitLetters)
+	n := min(l.length, src.length)
+	l.copyAt(0, src, n)
+	return n
+}
+
+// copyAt copies the first n bases of src into the backing array of the receiver starting
+// at position i of the receiver.
+func (l TwoBitLetters) copyAt(i int, src TwoBitLetters, n int) {
+	if n == 0 {
+		return
+	}
+	d, s := l.off+i, src.off
+	runs := src.mask.within(s, s+n)
+	switch {
+	case sameArray(l.data, src.data):
+		if d > s {
+			for k := n - 1; k >= 0; k-- {
+				set2(l.data, d+k, get2(src.data, s+k))
+			}
+		} else {
+			for k := 0; k < n; k++ {
+				set2(l.data, d+k, get2(src.data, s+k))
+			}
+		}
+	case d&3 == s&3:
+		// Copy whole bytes where the source and destination are aligned.
+		k := 0
+		for ; k < n && (d+k)&3 != 0; k++ {
+			set2(l.data, d+k, get2(src.data, s+k))
+		}
+		w := (n - k) &^ 3
+		copy(l.data[(d+k)>>2:(d+k+w)>>2], src.data[(s+k)>>2:(s+k+w)>>2])
+		for k += w; k < n; k++ {
+			set2(l.data, d+k, get2(src.data, s+k))
+		}
+	default:
+		for k := 0; k < n; k++ {
+			set2(l.data, d+k, get2(src.data, s+k))
+		}
+	}
+	l.mask.clear(d, d+n)
+	for _, r := range runs {
+		l.mask.add(r.start-s+d, r.end-s+d)
+	}
+}
+
+// at returns the base at position i of the slice.
+func (l TwoBitLetters) at(i int) alphabet.Letter {
+	if i < 0 || i >= l.length {
+		panic("packed: index out of range")
+	}
+	p := l.off + i
+	if l.mask.contains(p) {
+		return 'n'
+	}
+	return twoBitLetters[get2(l.data, p)]
+}
+
+// set sets the base at position i of the slice to b.
+func (l TwoBitLetters) set(i int, b alphabet.Letter) {
+	if i < 0 || i >= l.length {
+		panic("packed: index out of range")
+	}
+	p := l.off + i
+	v := twoBitCode[b]
+	if v == 0xff {
+		l.mask.add(p, p+1)
+		set2(l.data, p, 0)
+		return
+	}
+	l.mask.clear(p, p+1)
+	set2(l.data, p, v)
+}
+
+// reverse reverses the order of bases in the slice, complementing them if comp is true.
+func (l TwoBitLetters) reverse(comp bool) {
+	d := l.data
+	for i, j := l.off, l.off+l.length-1; i <= j; i, j = i+1, j-1 {
+		a, b := get2(d, i), get2(d, j)
+		if comp {
+			a, b = 3-a, 3-b
+		}
+		set2(d, i, b)
+		set2(d, j, a)
+	}
+	start, end := l.off, l.off+l.length
+	runs := l.mask.within(start, end)
+	if len(runs) == 0 {
+		return
+	}
+	l.mask.clear(start, end)
+	for _, r := range runs {
+		l.mask.add(start+end-r.end, start+end-r.start)
+	}
+}
+
+// letters returns the bases of the slice as alphabet.Letters.
+func (l TwoBitLetters) letters() alphabet.Letters {
+	b := make(alphabet.Letters, l.length)
+	for i := range b {
+		b[i] = twoBitLetters[get2(l.data, l.off+i)]
+	}
+	for _, r := range l.mask.within(l.off, l.off+l.length) {
+		for p := r.start; p < r.end; p++ {
+			b[p-l.off] = 'n'
+		}
+	}
+	return b
+}
+
+// clone returns a copy of the slice with its own backing array.
+func (l TwoBitLetters) clone() TwoBitLetters {
+	c := l.Make(l.length, l.length).(TwoBitLetters)
+	c.copyAt(0, l, l.length)
+	return c
+}
+
+// String returns a string representation of the bases in the slice.
+func (l TwoBitLetters) String() string { return l.letters().String() }
+
+// A TwoBit is a nucleic acid sequence with bases packed four to a byte. Bases other than
+// a, c, g, t and u are stored as n, and u is stored as t.
+type TwoBit struct {
+	seq.Annotation
+	Seq TwoBitLetters
+}
+
+// Interface guarantees
+var (
+	_ feat.Feature = (*TwoBit)(nil)
+	_ seq.Sequence = (*TwoBit)(nil)
+)
+
+// NewTwoBit creates a new TwoBit with the given id and letter sequence, and the
+// alphabet.DNA alphabet.
+func NewTwoBit(id string, b []alphabet.Letter) *TwoBit {
+	return &TwoBit{
+		Annotation: seq.Annotation{
+			ID:     id,
+			Alpha:  alphabet.DNA,
+			Strand: seq.Plus,
+		},
+		Seq: newTwoBitLetters(b),
+	}
+}
+
+// TwoBitOf returns a TwoBit holding the letters and annotation of s. The alphabet of
+// the returned sequence is alphabet.DNA.
+func TwoBitOf(s *linear.Seq) *TwoBit {
+	t := &TwoBit{Annotation: *s.CloneAnnotation(), Seq: newTwoBitLetters(s.Seq)}
+	t.Alpha = alphabet.DNA
+	return t
+}
+
+// Linear returns a linear.Seq holding the letters and annotation of the sequence.
+func (s *TwoBit) Linear() *linear.Seq {
+	return &linear.Seq{Annotation: *s.CloneAnnotation(), Seq: s.Seq.letters()}
+}
+
+// Slice returns the sequence data as a alphabet.Slice.
+func (s *TwoBit) Slice() alphabet.Slice { return s.Seq }
+
+// SetSlice sets the sequence data represented by the sequence. SetSlice will panic if sl
+// is not a TwoBitLetters.
+func (s *TwoBit) SetSlice(sl alphabet.Slice) { s.Seq = sl.(TwoBitLetters) }
+
+// At returns the letter at position pos.
+func (s *TwoBit) At(i int) alphabet.QLetter {
+	return alphabet.QLetter{
+		L: s.Seq.at(i - s.Offset),
+		Q: seq.DefaultQphred,
+	}
+}
+
+// Set sets the letter at position pos to l.
+func (s *TwoBit) Set(i int, l alphabet.QLetter) error {
+	s.Seq.set(i-s.Offset, l.L)
+	return nil
+}
+
+// Len returns the length of the sequence.
+func (s *TwoBit) Len() int { return s.Seq.Len() }
+
+// Start returns the start position of the sequence in coordinates relative to the sequence
+// location.
+func (s *TwoBit) Start() int { return s.Offset }
+
+// End returns the end position of the sequence in coordinates relative to the sequence
+// location.
+func (s *TwoBit) End() int { return s.Offset + s.Len() }
+
+// Clone returns a copy of the sequence.
+func (s *TwoBit) Clone() seq.Sequence {
+	c := *s
+	c.Seq = s.Seq.clone()
+	return &c
+}
+
+// New returns an empty *TwoBit sequence with the same alphabet.
+func (s *TwoBit) New() seq.Sequence {
+	return &TwoBit{Annotation: seq.Annotation{Alpha: s.Alpha}}
+}
+
+// RevComp reverse complements the sequence.
+func (s *TwoBit) RevComp() {
+	s.Seq.reverse(true)
+	s.Strand = -s.Strand
+}
+
+// Reverse reverses the order of letters in the the sequence without complementing them.
+func (s *TwoBit) Reverse() {
+	s.Seq.reverse(false)
+	s.Strand = seq.None
+}
+
+// String returns a string representation of the sequence data only.
+func (s *TwoBit) String() string { return s.Seq.String() }